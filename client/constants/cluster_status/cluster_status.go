@@ -0,0 +1,6 @@
+package cluster_status
+
+const (
+	READY  = "READY"
+	FAILED = "FAILED"
+)
@@ -0,0 +1,16 @@
+package task_status
+
+const (
+	SUCCESS = "SUCCESS"
+	FAILED  = "FAILED"
+)
+
+// IsTerminal returns true if the task will not transition to any other status.
+func IsTerminal(status string) bool {
+	switch status {
+	case SUCCESS, FAILED:
+		return true
+	default:
+		return false
+	}
+}
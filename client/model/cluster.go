@@ -2,26 +2,28 @@ package model
 
 // Cluster -
 type Cluster struct {
-	ID                   string           `json:"id,omitempty"`
-	OrgId                string           `json:"orgId"`
-	Name                 string           `json:"name"`
-	ServiceType          string           `json:"serviceType"`
-	Provider             string           `json:"provider"`
-	InstanceSize         string           `json:"instanceSize"`
-	Region               string           `json:"region"`
-	Tags                 []string         `json:"tags"`
-	Version              string           `json:"version"`
-	Status               string           `json:"status"`
-	DataPlaneId          string           `json:"dataPlaneId"`
-	Metadata             *ClusterMetadata `json:"metadata"`
-	Created              string           `json:"created"`
-	LastUpdated          string           `json:"lastUpdated"`
-	StoragePolicyName    string           `json:"storagePolicyName"`
-	IsAuthorized         bool             `json:"isAuthorized,omitempty"`
-	MaintenanceStartTime int64            `json:"maintenanceStartTime,omitempty"`
-	MaintenanceEndTime   int64            `json:"maintenanceEndTime,omitempty"`
-	UpgradeInProgress    bool             `json:"isUpgradeInProgress"`
-	PauseUpdates         bool             `json:"pauseUpdates"`
+	ID                   string            `json:"id,omitempty"`
+	OrgId                string            `json:"orgId"`
+	Name                 string            `json:"name"`
+	ServiceType          string            `json:"serviceType"`
+	Provider             string            `json:"provider"`
+	InstanceSize         string            `json:"instanceSize"`
+	Region               string            `json:"region"`
+	Tags                 []string          `json:"tags"`
+	Version              string            `json:"version"`
+	Status               string            `json:"status"`
+	DataPlaneId          string            `json:"dataPlaneId"`
+	Metadata             *ClusterMetadata  `json:"metadata"`
+	Created              string            `json:"created"`
+	LastUpdated          string            `json:"lastUpdated"`
+	StoragePolicyName    string            `json:"storagePolicyName"`
+	IsAuthorized         bool              `json:"isAuthorized,omitempty"`
+	MaintenanceStartTime int64             `json:"maintenanceStartTime,omitempty"`
+	MaintenanceEndTime   int64             `json:"maintenanceEndTime,omitempty"`
+	UpgradeInProgress    bool              `json:"isUpgradeInProgress"`
+	PauseUpdates         bool              `json:"pauseUpdates"`
+	StorageUsed          string            `json:"storageUsed,omitempty"`
+	Parameters           map[string]string `json:"parameters,omitempty"`
 }
 
 type ClusterMetadata struct {
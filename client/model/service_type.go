@@ -0,0 +1,12 @@
+package model
+
+// ServiceType - Type of service offered by TDH & what its clusters support
+type ServiceType struct {
+	Name                string   `json:"name" tfsdk:"name"`
+	BackupSupported     bool     `json:"backupSupported" tfsdk:"backup_supported"`
+	ExtensionsSupported bool     `json:"extensionsSupported" tfsdk:"extensions_supported"`
+	CredentialsRequired bool     `json:"credentialsRequired" tfsdk:"credentials_required"`
+	DatabaseRequired    bool     `json:"databaseRequired" tfsdk:"database_required"`
+	CloneSupported      bool     `json:"cloneSupported" tfsdk:"clone_supported"`
+	Parameters          []string `json:"parameters" tfsdk:"parameters"`
+}
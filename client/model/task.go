@@ -1,11 +1,27 @@
 package model
 
+import "github.com/svc-bot-mds/terraform-provider-tdh/client/constants/task_status"
+
 type Task struct {
-	Id          string   `json:"id"`
-	TaskType    string   `json:"taskType"`
-	Status      string   `json:"status"`
-	DisplayName string   `json:"displayName,omitempty"`
-	UiParams    UiParams `json:"uiParams"`
+	Id            string     `json:"id"`
+	TaskType      string     `json:"taskType"`
+	Status        string     `json:"status"`
+	DisplayName   string     `json:"displayName,omitempty"`
+	ErrorMessage  string     `json:"errorMessage,omitempty"`
+	Steps         []TaskStep `json:"steps,omitempty"`
+	Created       string     `json:"created,omitempty"`
+	Modified      string     `json:"modified,omitempty"`
+	TimeStarted   string     `json:"timeStarted,omitempty"`
+	TimeCompleted string     `json:"timeCompleted,omitempty"`
+	UiParams      UiParams   `json:"uiParams"`
+}
+
+type TaskStep struct {
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	ErrorMessage  string `json:"errorMessage,omitempty"`
+	TimeStarted   string `json:"timeStarted,omitempty"`
+	TimeCompleted string `json:"timeCompleted,omitempty"`
 }
 
 type UiParams struct {
@@ -13,3 +29,30 @@ type UiParams struct {
 	ResourceName string `json:"resourceName"`
 	ServiceType  string `json:"serviceType"`
 }
+
+// LastStep returns the last step the task has reached, i.e. the failed step if any,
+// otherwise the latest step that has started. Returns nil if no step has started yet.
+func (t *Task) LastStep() *TaskStep {
+	var last *TaskStep
+	for i := range t.Steps {
+		step := &t.Steps[i]
+		if step.Status == task_status.FAILED {
+			return step
+		}
+		if step.TimeStarted != "" || step.Status == task_status.SUCCESS {
+			last = step
+		}
+	}
+	return last
+}
+
+// FailureReason returns the error reported by the server for a failed task, falling back to the error of the failed step.
+func (t *Task) FailureReason() string {
+	if t.ErrorMessage != "" {
+		return t.ErrorMessage
+	}
+	if step := t.LastStep(); step != nil {
+		return step.ErrorMessage
+	}
+	return ""
+}
@@ -20,4 +20,14 @@ const (
 	Versions          = "versions"
 	Extensions        = "extensions"
 	Customers         = "mdscustomers"
+	Scale             = "scale"
+	Credentials       = "credentials"
+	VHosts            = "vhosts"
+	Queues            = "queues"
+	Exchanges         = "exchanges"
+	Bindings          = "bindings"
+	Databases         = "databases"
+	Schemas           = "schemas"
+	Parameters        = "parameters"
+	ServiceTypes      = "serviceTypes"
 )
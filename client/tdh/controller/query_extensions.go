@@ -2,4 +2,5 @@ package controller
 
 type ServiceExtensionsQuery struct {
 	ServiceType string `schema:"serviceType,omitempty"`
+	Version     string `schema:"version,omitempty"`
 }
@@ -0,0 +1,6 @@
+package controller
+
+type ClusterDatabaseRequest struct {
+	Name  string `json:"name"`
+	Owner string `json:"owner,omitempty"`
+}
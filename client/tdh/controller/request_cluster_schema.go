@@ -0,0 +1,7 @@
+package controller
+
+type ClusterSchemaRequest struct {
+	Database string `json:"database"`
+	Name     string `json:"name"`
+	Owner    string `json:"owner,omitempty"`
+}
@@ -1,19 +1,20 @@
 package controller
 
 type ClusterCreateRequest struct {
-	Name              string          `json:"name"`
-	ServiceType       string          `json:"serviceType"`
-	Provider          string          `json:"provider"`
-	InstanceSize      string          `json:"instanceSize"`
-	Region            string          `json:"region"`
-	Dedicated         bool            `json:"dedicated"`
-	Shared            bool            `json:"shared,omitempty"`
-	Tags              []string        `json:"tags,omitempty"`
-	NetworkPolicyIds  []string        `json:"networkPolicyIds,omitempty"`
-	DataPlaneId       string          `json:"dataPlaneId,omitempty"`
-	Version           string          `json:"version"`
-	StoragePolicyName string          `json:"storagePolicyName"`
-	ClusterMetadata   ClusterMetadata `json:"clusterMetadata"`
+	Name              string            `json:"name"`
+	ServiceType       string            `json:"serviceType"`
+	Provider          string            `json:"provider"`
+	InstanceSize      string            `json:"instanceSize"`
+	Region            string            `json:"region"`
+	Dedicated         bool              `json:"dedicated"`
+	Shared            bool              `json:"shared,omitempty"`
+	Tags              []string          `json:"tags,omitempty"`
+	NetworkPolicyIds  []string          `json:"networkPolicyIds,omitempty"`
+	DataPlaneId       string            `json:"dataPlaneId,omitempty"`
+	Version           string            `json:"version"`
+	StoragePolicyName string            `json:"storagePolicyName"`
+	ClusterMetadata   ClusterMetadata   `json:"clusterMetadata"`
+	Parameters        map[string]string `json:"parameters,omitempty"`
 }
 
 type ClusterMetadata struct {
@@ -0,0 +1,10 @@
+package controller
+
+type RabbitMqBindingRequest struct {
+	Source          string            `json:"source"`
+	VHost           string            `json:"vhost"`
+	Destination     string            `json:"destination"`
+	DestinationType string            `json:"destinationType"`
+	RoutingKey      string            `json:"routingKey"`
+	Arguments       map[string]string `json:"arguments,omitempty"`
+}
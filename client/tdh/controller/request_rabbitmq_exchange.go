@@ -0,0 +1,11 @@
+package controller
+
+type RabbitMqExchangeRequest struct {
+	Name       string            `json:"name"`
+	VHost      string            `json:"vhost"`
+	Type       string            `json:"type,omitempty"`
+	Durable    bool              `json:"durable"`
+	AutoDelete bool              `json:"autoDelete"`
+	Internal   bool              `json:"internal"`
+	Arguments  map[string]string `json:"arguments,omitempty"`
+}
@@ -0,0 +1,9 @@
+package controller
+
+type RabbitMqQueueRequest struct {
+	Name       string            `json:"name"`
+	VHost      string            `json:"vhost"`
+	Durable    bool              `json:"durable"`
+	AutoDelete bool              `json:"autoDelete"`
+	Arguments  map[string]string `json:"arguments,omitempty"`
+}
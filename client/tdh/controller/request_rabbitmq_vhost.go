@@ -0,0 +1,5 @@
+package controller
+
+type RabbitMqVhostRequest struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,5 @@
+package controller
+
+type ClusterScaleRequest struct {
+	InstanceSize string `json:"instanceSize"`
+}
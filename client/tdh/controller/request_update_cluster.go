@@ -1,5 +1,8 @@
 package controller
 
 type ClusterUpdateRequest struct {
-	Tags []string `json:"tags"`
+	Tags                 []string `json:"tags"`
+	MaintenanceStartTime int64    `json:"maintenanceStartTime,omitempty"`
+	MaintenanceEndTime   int64    `json:"maintenanceEndTime,omitempty"`
+	PauseUpdates         *bool    `json:"pauseUpdates,omitempty"`
 }
@@ -0,0 +1,6 @@
+package controller
+
+type ClusterCredentialsUpdateRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
@@ -0,0 +1,5 @@
+package controller
+
+type ClusterExtensionsUpdateRequest struct {
+	Extensions []string `json:"extensions"`
+}
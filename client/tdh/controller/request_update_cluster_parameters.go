@@ -0,0 +1,5 @@
+package controller
+
+type ClusterParametersUpdateRequest struct {
+	Parameters map[string]string `json:"parameters"`
+}
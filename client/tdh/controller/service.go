@@ -63,6 +63,24 @@ func (s *Service) GetClusterBackups(query *BackupsQuery) (model.Paged[model.Clus
 	return response, nil
 }
 
+// GetAllClusterBackups - Returns list of all the Backups
+func (s *Service) GetAllClusterBackups(query *BackupsQuery) ([]model.ClusterBackup, error) {
+	var backups []model.ClusterBackup
+	for {
+		queriedBackups, err := s.GetClusterBackups(query)
+		if err != nil {
+			return backups, err
+		}
+		backups = append(backups, *queriedBackups.Get()...)
+		nextPage := utils.GetNextPageInfo(queriedBackups.GetPage())
+		if nextPage == nil {
+			break
+		}
+		query.PageQuery = *nextPage
+	}
+	return backups, nil
+}
+
 // GetClusterRestores - Returns all the Restore
 func (s *Service) GetClusterRestores(query RestoreQuery) (model.Paged[model.ClusterRestore], error) {
 	urlPath := fmt.Sprintf("%s/%s", s.Endpoint, Restore)
@@ -80,6 +98,24 @@ func (s *Service) GetClusterRestores(query RestoreQuery) (model.Paged[model.Clus
 	return response, nil
 }
 
+// GetAllClusterRestores - Returns list of all the Restores
+func (s *Service) GetAllClusterRestores(query RestoreQuery) ([]model.ClusterRestore, error) {
+	var restores []model.ClusterRestore
+	for {
+		queriedRestores, err := s.GetClusterRestores(query)
+		if err != nil {
+			return restores, err
+		}
+		restores = append(restores, *queriedRestores.Get()...)
+		nextPage := utils.GetNextPageInfo(queriedRestores.GetPage())
+		if nextPage == nil {
+			break
+		}
+		query.PageQuery = *nextPage
+	}
+	return restores, nil
+}
+
 // RestoreClusterBackup - Restores a cluster backup
 func (s *Service) RestoreClusterBackup(request *ClusterCreateRequest) (*model.TaskResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s", s.Endpoint, Restore)
@@ -108,6 +144,21 @@ func (s *Service) GetServiceVersions(query *ServiceVersionsQuery) ([]string, err
 	return response.Versions, nil
 }
 
+// GetServiceTypes - Returns the types of services enabled, along with their capabilities
+func (s *Service) GetServiceTypes() ([]model.ServiceType, error) {
+	urlPath := fmt.Sprintf("%s/%s/%s", s.Endpoint, Services, ServiceTypes)
+	var response struct {
+		ServiceTypes []model.ServiceType `json:"serviceTypes"`
+	}
+
+	_, err := s.Api.Get(&urlPath, nil, &response)
+	if err != nil {
+		return response.ServiceTypes, err
+	}
+
+	return response.ServiceTypes, nil
+}
+
 // GetServiceExtensions - Returns all the extensions available
 func (s *Service) GetServiceExtensions(query *ServiceExtensionsQuery) (model.Paged[model.Extension], error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", s.Endpoint, Services, Extensions)
@@ -209,6 +260,348 @@ func (s *Service) UpdateClusterNetworkPolicies(id string, requestBody *ClusterNe
 	return &response, nil
 }
 
+// ScaleCluster - Submits a request to change the instance size of cluster
+func (s *Service) ScaleCluster(id string, requestBody *ClusterScaleRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Scale)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateClusterExtensions - Submits a request to update the set of extensions enabled on cluster
+func (s *Service) UpdateClusterExtensions(id string, requestBody *ClusterExtensionsUpdateRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Extensions)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateClusterParameters - Submits a request to change the service specific configuration parameters of the cluster
+func (s *Service) UpdateClusterParameters(id string, requestBody *ClusterParametersUpdateRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Parameters)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateClusterCredentials - Submits a request to rotate the password of the cluster admin user
+func (s *Service) UpdateClusterCredentials(id string, requestBody *ClusterCredentialsUpdateRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Credentials)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterVhost - Submits a request to declare a virtual host on RabbitMQ cluster
+func (s *Service) CreateClusterVhost(id string, requestBody *RabbitMqVhostRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, VHosts)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterVhost - Submits a request to delete a virtual host from RabbitMQ cluster
+func (s *Service) DeleteClusterVhost(id string, requestBody *RabbitMqVhostRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, VHosts)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterQueue - Submits a request to declare a queue on RabbitMQ cluster
+func (s *Service) CreateClusterQueue(id string, requestBody *RabbitMqQueueRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Queues)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterQueue - Submits a request to delete a queue from RabbitMQ cluster
+func (s *Service) DeleteClusterQueue(id string, requestBody *RabbitMqQueueRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Queues)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterExchange - Submits a request to declare an exchange on RabbitMQ cluster
+func (s *Service) CreateClusterExchange(id string, requestBody *RabbitMqExchangeRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Exchanges)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterExchange - Submits a request to delete an exchange from RabbitMQ cluster
+func (s *Service) DeleteClusterExchange(id string, requestBody *RabbitMqExchangeRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Exchanges)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterBinding - Submits a request to declare a binding on RabbitMQ cluster
+func (s *Service) CreateClusterBinding(id string, requestBody *RabbitMqBindingRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Bindings)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterBinding - Submits a request to delete a binding from RabbitMQ cluster
+func (s *Service) DeleteClusterBinding(id string, requestBody *RabbitMqBindingRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Bindings)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterDatabase - Submits a request to create a database on cluster
+func (s *Service) CreateClusterDatabase(id string, requestBody *ClusterDatabaseRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Databases)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateClusterDatabase - Submits a request to change the owner of a database on cluster
+func (s *Service) UpdateClusterDatabase(id string, requestBody *ClusterDatabaseRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Databases)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterDatabase - Submits a request to drop a database from cluster
+func (s *Service) DeleteClusterDatabase(id string, requestBody *ClusterDatabaseRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Databases)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// CreateClusterSchema - Submits a request to create a schema on cluster
+func (s *Service) CreateClusterSchema(id string, requestBody *ClusterSchemaRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Schemas)
+	var response model.TaskResponse
+
+	_, err := s.Api.Post(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateClusterSchema - Submits a request to change the owner of a schema on cluster
+func (s *Service) UpdateClusterSchema(id string, requestBody *ClusterSchemaRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Schemas)
+	var response model.TaskResponse
+
+	_, err := s.Api.Patch(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// DeleteClusterSchema - Submits a request to drop a schema from cluster
+func (s *Service) DeleteClusterSchema(id string, requestBody *ClusterSchemaRequest) (*model.TaskResponse, error) {
+	if id == "" {
+		return nil, fmt.Errorf("cluster ID cannot be empty")
+	}
+	if requestBody == nil {
+		return nil, fmt.Errorf("requestBody cannot be nil")
+	}
+	urlPath := fmt.Sprintf("%s/%s/%s/%s", s.Endpoint, Clusters, id, Schemas)
+	var response model.TaskResponse
+
+	_, err := s.Api.Delete(&urlPath, requestBody, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // DeleteCluster - Submits a request to delete cluster
 func (s *Service) DeleteCluster(id string) (*model.TaskResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", s.Endpoint, Clusters, id)
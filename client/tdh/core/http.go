@@ -2,7 +2,6 @@ package core
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -65,7 +64,8 @@ func (r *Root) doRequest(req *http.Request) ([]byte, error) {
 		}
 		var apiError ApiError
 		if err = json.Unmarshal(body, &apiError); err != nil {
-			return nil, errors.Join(errorWithMsg)
+			// keep the status code available to callers, even when body isn't the usual API error
+			apiError = ApiError{}
 		}
 		apiError.error = errorWithMsg
 		apiError.StatusCode = res.StatusCode
@@ -5,6 +5,7 @@ import (
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/account_type"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/utils"
 	"strings"
 )
 
@@ -45,6 +46,24 @@ func (s *Service) GetPolicies(query *PoliciesQuery) (model.Paged[model.Policy],
 	return response, nil
 }
 
+// GetAllPolicies - Returns list of all Policies matching the query
+func (s *Service) GetAllPolicies(query *PoliciesQuery) ([]model.Policy, error) {
+	var policies []model.Policy
+	for {
+		queriedPolicies, err := s.GetPolicies(query)
+		if err != nil {
+			return policies, err
+		}
+		policies = append(policies, *queriedPolicies.Get()...)
+		nextPage := utils.GetNextPageInfo(queriedPolicies.GetPage())
+		if nextPage == nil {
+			break
+		}
+		query.PageQuery = *nextPage
+	}
+	return policies, nil
+}
+
 // GetUsers - Return list of Users
 func (s *Service) GetUsers(query *UsersQuery) (model.Paged[model.User], error) {
 	var response model.Paged[model.User]
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/utils"
 	"strings"
 )
 
@@ -232,6 +233,24 @@ func (s *Service) GetDataPlanes(query *DataPlanesQuery) (model.Paged[model.DataP
 	return response, nil
 }
 
+// GetAllDataPlanes - Returns list of all data planes matching the query
+func (s *Service) GetAllDataPlanes(query *DataPlanesQuery) ([]model.DataPlane, error) {
+	var dataPlanes []model.DataPlane
+	for {
+		queriedDataPlanes, err := s.GetDataPlanes(query)
+		if err != nil {
+			return dataPlanes, err
+		}
+		dataPlanes = append(dataPlanes, *queriedDataPlanes.Get()...)
+		nextPage := utils.GetNextPageInfo(queriedDataPlanes.GetPage())
+		if nextPage == nil {
+			break
+		}
+		query.PageQuery = *nextPage
+	}
+	return dataPlanes, nil
+}
+
 func (s *Service) GetEligibleDataPlanes(query *EligibleDataPlanesQuery) (model.Paged[model.EligibleDataPlane], error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", s.Endpoint, K8sCluster, Eligible)
 	var response model.Paged[model.EligibleDataPlane]
@@ -3,6 +3,11 @@ package task
 import "github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 
 type TasksQuery struct {
-	ResourceName string `schema:"resourceName,omitempty"`
+	ResourceName string   `schema:"resourceName,omitempty"`
+	ResourceId   string   `schema:"resourceId,omitempty"`
+	Status       string   `schema:"status,omitempty"`
+	TaskType     string   `schema:"taskType,omitempty"`
+	ServiceType  string   `schema:"serviceType,omitempty"`
+	Ids          []string `schema:"ids,omitempty"`
 	model.PageQuery
 }
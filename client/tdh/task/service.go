@@ -34,11 +34,16 @@ func (s *Service) GetTasks(query *TasksQuery) (model.Paged[model.Task], error) {
 	urlPath := fmt.Sprintf("%s/%s", s.Endpoint, Tasks)
 	var response model.Paged[model.Task]
 
-	if query.Size == 0 {
-		query.Size = defaultPage.Size
+	// defaults are set on a copy, leaving the query of caller as is
+	pageQuery := *query
+	if pageQuery.Size == 0 {
+		pageQuery.Size = defaultPage.Size
+	}
+	if pageQuery.Sort == "" {
+		pageQuery.Sort = defaultPage.Sort
 	}
 
-	_, err := s.Api.Get(&urlPath, query, &response)
+	_, err := s.Api.Get(&urlPath, &pageQuery, &response)
 	if err != nil {
 		return response, err
 	}
@@ -18,6 +18,7 @@ const UsersId = "users"
 const LocalUsersId = "local_users"
 const CloudAccountsId = "cloud_accounts"
 const ProviderTypesId = "provider_types"
+const ServiceTypesId = "service_types"
 const TshirtSizeId = "tshirt_size"
 const CertificateId = "certificates"
 const ObjectStorageId = "object_storages"
@@ -0,0 +1,2 @@
+data "tdh_service_types" "all" {
+}
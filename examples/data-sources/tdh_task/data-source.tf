@@ -0,0 +1,13 @@
+data "tdh_task" "upgrade" {
+  id                  = "TASK_ID"
+  wait_for_completion = true
+  timeout             = "45m"
+}
+
+output "task" {
+  value = {
+    status        = data.tdh_task.upgrade.status
+    error_message = data.tdh_task.upgrade.error_message
+    last_step     = data.tdh_task.upgrade.last_step
+  }
+}
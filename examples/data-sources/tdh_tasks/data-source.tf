@@ -1,3 +1,15 @@
 data "tdh_tasks" "by-resource" {
   resource_name = "my-cluster"
-}
\ No newline at end of file
+}
+
+# audit of the operations that have failed in the last day
+data "tdh_tasks" "failed" {
+  status         = "FAILED"
+  modified_after = timeadd(timestamp(), "-24h")
+}
+
+# latest task of a cluster
+data "tdh_tasks" "latest" {
+  resource_id = "CLUSTER_ID"
+  most_recent = true
+}
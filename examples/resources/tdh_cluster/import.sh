@@ -1 +1,5 @@
+# import by ID
 terraform import tdh_cluster.example d3c49288-7b17-4e78-a6af-257b49e34e53
+
+# import by service type & name, in the format "<service_type>/<name>"
+terraform import tdh_cluster.example POSTGRES/my-postgres-cluster
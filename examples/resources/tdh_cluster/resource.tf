@@ -62,14 +62,90 @@ resource "tdh_cluster" "test" {
   tags                = ["tdh-tf", "new-tag"]
   version             = local.version             # available values can be fetched using datasource "tdh_service_versions"
   storage_policy_name = local.storage_policy_name # complete list can be got using datasource "tdh_eligible_data_planes"
+  deletion_protection = true                      # has to be set to false & applied before the cluster can be destroyed
+  final_backup        = true                      # backup is taken & completed before the cluster is deleted
   cluster_metadata = {
     username          = "test"
     password          = "Admin!23"
     database          = "test"
     object_storage_id = "OBJECT_STORE_ID" # can be used from datasource "tdh_object_storages"
   }
-  // non editable fields
+  parameters = {
+    max_connections = "200"
+    work_mem        = "8MB"
+  }
+  maintenance_window = {
+    day      = "SUNDAY"
+    time     = "02:00" # UTC
+    duration = "4h"
+  }
+  // non editable fields, changing them forces replacement of the cluster
   lifecycle {
-    ignore_changes = [instance_size, name, provider_type, region, service_type]
+    ignore_changes = [name, provider_type, region, service_type]
+  }
+}
+
+output "jdbc_url" {
+  value     = tdh_cluster.test.connection.jdbc_url
+  sensitive = true
+}
+
+// data plane, region & storage policy chosen by the provider
+resource "tdh_cluster" "placed" {
+  name               = "tf-redis-cls"
+  service_type       = "REDIS"
+  instance_size      = "XX-SMALL"
+  network_policy_ids = [tdh_network_policy.network.id]
+  version            = "REDIS_VERSION" # available values can be fetched using datasource "tdh_service_versions"
+  placement = {
+    provider       = local.provider_type
+    tags           = ["production"]
+    storage_policy = local.storage_policy_name
+  }
+  parameters = {
+    "maxmemory-policy" = "allkeys-lru"
+  }
+  cluster_metadata = {
+    username     = "test"
+    password_env = "TDH_REDIS_PASSWORD"
   }
-}
\ No newline at end of file
+}
+
+// several clusters provisioned at once, each adopted by a later run once created
+resource "tdh_cluster" "bulk" {
+  count              = 3
+  name               = "tf-redis-cls-${count.index}"
+  service_type       = "REDIS"
+  instance_size      = "XX-SMALL"
+  network_policy_ids = [tdh_network_policy.network.id]
+  version            = "REDIS_VERSION"
+  async_create       = true
+  placement = {
+    provider       = local.provider_type
+    storage_policy = local.storage_policy_name
+  }
+  cluster_metadata = {
+    username     = "test"
+    password_env = "TDH_REDIS_PASSWORD"
+  }
+}
+
+// clone of cluster "test", using its most recent completed backup
+resource "tdh_cluster" "clone" {
+  name                = "tf-pg-cls-staging"
+  service_type        = local.service_type
+  provider_type       = local.provider_type
+  instance_size       = "XX-SMALL"
+  region              = "REGION_NAME"
+  data_plane_id       = "DP_ID"
+  network_policy_ids  = [tdh_network_policy.network.id]
+  version             = local.version
+  storage_policy_name = local.storage_policy_name
+  source_cluster_id   = tdh_cluster.test.id
+  most_recent_backup  = true # or set "source_backup_id" to the ID of a specific backup instead
+  cluster_metadata = {
+    username = "test"
+    password = "Admin!23"
+    database = "test"
+  }
+}
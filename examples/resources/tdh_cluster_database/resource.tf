@@ -0,0 +1,5 @@
+resource "tdh_cluster_database" "inventory" {
+  cluster_id = "CLUSTER_ID" # ID of a POSTGRES or MYSQL cluster, use datasource "tdh_clusters" to see available clusters
+  name       = "inventory"
+  owner      = "inventory_admin" # optional, defaults to the admin user of the cluster
+}
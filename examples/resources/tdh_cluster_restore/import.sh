@@ -0,0 +1,2 @@
+# ID of the restore, use datasource "tdh_cluster_restores" to see available restores
+terraform import tdh_cluster_restore.staging 0d0a1b7e-5f0c-4d3e-a1a8-3c9e2b8f6a11
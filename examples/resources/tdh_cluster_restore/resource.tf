@@ -0,0 +1,18 @@
+// restore into a new cluster
+resource "tdh_cluster_restore" "staging" {
+  backup_id          = "BACKUP_ID" # completed backup, use datasource "tdh_cluster_backups" to see available backups
+  cluster_name       = "tf-pg-cls-restored"
+  storage_policy     = "tdh-k8s-cluster-policy"
+  network_policy_ids = ["NETWORK_POLICY_ID"]
+  tags               = ["restored"]
+}
+
+output "restored_cluster_id" {
+  value = tdh_cluster_restore.staging.cluster_id
+}
+
+// restore onto the cluster the backup was taken of, only option for REDIS backups
+resource "tdh_cluster_restore" "redis" {
+  backup_id = "REDIS_BACKUP_ID"
+  in_place  = true
+}
@@ -0,0 +1,2 @@
+# <cluster_id>/<database>/<name>, with the database & name URL-encoded
+terraform import tdh_cluster_schema.reporting 6b7b0f3e-2c7d-4f55-9d7a-0e1f5c3b8a42/inventory/reporting
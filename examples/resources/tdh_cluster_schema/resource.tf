@@ -0,0 +1,5 @@
+resource "tdh_cluster_schema" "reporting" {
+  cluster_id = tdh_cluster_database.inventory.cluster_id # ID of a POSTGRES cluster
+  database   = tdh_cluster_database.inventory.name
+  name       = "reporting"
+}
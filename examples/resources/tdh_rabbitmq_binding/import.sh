@@ -0,0 +1,2 @@
+# <cluster_id>/<vhost>/<source>/<destination_type>/<destination>/<routing_key>, with all the parts except cluster ID URL-encoded
+terraform import tdh_rabbitmq_binding.created 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders/orders.events/queue/orders.created/order.created.%23
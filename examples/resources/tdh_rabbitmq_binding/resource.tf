@@ -0,0 +1,8 @@
+resource "tdh_rabbitmq_binding" "created" {
+  cluster_id       = tdh_rabbitmq_vhost.orders.cluster_id
+  vhost            = tdh_rabbitmq_vhost.orders.name # default is "/"
+  source           = tdh_rabbitmq_exchange.events.name
+  destination      = tdh_rabbitmq_queue.created.name
+  destination_type = "queue"
+  routing_key      = "order.created.#"
+}
@@ -0,0 +1,5 @@
+# <cluster_id>/<vhost>/<name>, with the virtual host & name URL-encoded
+terraform import tdh_rabbitmq_exchange.events 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders/orders.events
+
+# <cluster_id>/<name> for an exchange in the default virtual host "/"
+terraform import tdh_rabbitmq_exchange.events 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders.events
@@ -0,0 +1,6 @@
+resource "tdh_rabbitmq_exchange" "events" {
+  cluster_id = tdh_rabbitmq_vhost.orders.cluster_id
+  vhost      = tdh_rabbitmq_vhost.orders.name # default is "/"
+  name       = "orders.events"
+  type       = "topic"
+}
@@ -0,0 +1,5 @@
+# <cluster_id>/<vhost>/<name>, with the virtual host & name URL-encoded
+terraform import tdh_rabbitmq_queue.created 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders/orders.created
+
+# <cluster_id>/<name> for a queue in the default virtual host "/"
+terraform import tdh_rabbitmq_queue.created 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders.created
@@ -0,0 +1,9 @@
+resource "tdh_rabbitmq_queue" "created" {
+  cluster_id = tdh_rabbitmq_vhost.orders.cluster_id
+  vhost      = tdh_rabbitmq_vhost.orders.name # default is "/"
+  name       = "orders.created"
+  durable    = true
+  arguments = {
+    "x-queue-type" = "quorum"
+  }
+}
@@ -0,0 +1,2 @@
+# <cluster_id>/<name>, with the name URL-encoded
+terraform import tdh_rabbitmq_vhost.orders 9c0b1e5a-6f8e-4a0f-bb34-2d6f0c7f1e21/orders
@@ -0,0 +1,4 @@
+resource "tdh_rabbitmq_vhost" "orders" {
+  cluster_id = "CLUSTER_ID" # ID of a RabbitMQ cluster, use datasource "tdh_clusters" to see available clusters
+  name       = "orders"
+}
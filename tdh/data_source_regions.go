@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
@@ -92,7 +91,7 @@ func (d *regionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			},
 			"instance_size": schema.StringAttribute{
 				MarkdownDescription: "Type of instance size. Supported values: `XX-SMALL`, `X-SMALL`, `SMALL`, `SMALL-LITE`, `LARGE`, `XX-LARGE`.\n" +
-					"**Note:** `SMALL-LITE` instance size is applicable only for `POSTGRES` service type.",
+					"Sizes available for each service type can be fetched using datasource `tdh_instance_types`.",
 				Required: true,
 				Validators: []validator.String{
 					validators.EmptyStringValidator{},
@@ -153,12 +152,6 @@ func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	//Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	if state.ServiceType.ValueString() != service_type.POSTGRES && state.InstanceSize.ValueString() == "SMALL-LITE" {
-		resp.Diagnostics.AddAttributeError(path.Root("instance_size"),
-			"Invalid input", fmt.Sprintf("Instance Size \"%s\" is not available for Service \"%s\"", state.InstanceSize.ValueString(), state.ServiceType.ValueString()))
-		return
-	}
-
 	regionQuery := &infra_connector.DataPlaneRegionsQuery{
 		CPU:       state.Cpu.ValueString(),
 		NodeCount: state.NodeCount.ValueString(),
@@ -178,12 +171,18 @@ func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			)
 			return
 		}
+		allowedSizes := make([]string, 0, len(instanceTypes.InstanceTypes))
 		for _, instanceType := range instanceTypes.InstanceTypes {
+			allowedSizes = append(allowedSizes, instanceType.InstanceSize)
 			if state.InstanceSize.ValueString() == instanceType.InstanceSize {
 				typeDetail = instanceType
-				break
 			}
 		}
+		if typeDetail.InstanceSize == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("instance_size"), "Invalid input",
+				fmt.Sprintf("Instance Size %q is not available for Service %q, allowed values: %q", state.InstanceSize.ValueString(), state.ServiceType.ValueString(), allowedSizes))
+			return
+		}
 	}
 	regionQuery.CPU = typeDetail.CPU
 	regionQuery.Memory = typeDetail.Memory
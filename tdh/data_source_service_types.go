@@ -0,0 +1,113 @@
+package tdh
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/constants/common"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serviceTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &serviceTypesDataSource{}
+)
+
+type serviceTypesDataSourceModel struct {
+	Id           types.String        `tfsdk:"id"`
+	ServiceTypes []model.ServiceType `tfsdk:"list"`
+}
+
+func NewServiceTypesDataSource() datasource.DataSource {
+	return &serviceTypesDataSource{}
+}
+
+type serviceTypesDataSource struct {
+	client *tdh.Client
+}
+
+// Metadata returns the data source type name.
+func (d *serviceTypesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_types"
+}
+
+func (d *serviceTypesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Used to fetch types of services enabled on TDH, along with what their clusters support. " +
+			"If they can't be discovered, the ones known to this version of the provider are listed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The testing framework requires an id attribute to be present in every data source and resource.",
+			},
+			"list": schema.ListNestedAttribute{
+				Description: "List of service types.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the service type, to be used as `service_type`.",
+							Computed:    true,
+						},
+						"backup_supported": schema.BoolAttribute{
+							Description: "Whether clusters of this type can be backed up.",
+							Computed:    true,
+						},
+						"extensions_supported": schema.BoolAttribute{
+							Description: "Whether extensions can be enabled on clusters of this type.",
+							Computed:    true,
+						},
+						"credentials_required": schema.BoolAttribute{
+							Description: "Whether credentials of the admin user are required to create clusters of this type.",
+							Computed:    true,
+						},
+						"database_required": schema.BoolAttribute{
+							Description: "Whether a database name is required to create clusters of this type.",
+							Computed:    true,
+						},
+						"clone_supported": schema.BoolAttribute{
+							Description: "Whether clusters of this type can be created from a backup of another cluster.",
+							Computed:    true,
+						},
+						"parameters": schema.ListAttribute{
+							Description: "Configuration parameters allowed to be set on clusters of this type.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serviceTypesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*tdh.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serviceTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "getServiceTypes")
+	var state serviceTypesDataSourceModel
+
+	//Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	state.Id = types.StringValue(common.DataSource + common.ServiceTypesId)
+	state.ServiceTypes = utils.GetServiceTypes(ctx, d.client)
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,265 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+	"time"
+)
+
+const defaultTaskWaitTimeout = 30 * time.Minute
+
+var (
+	_ datasource.DataSource              = &taskDataSource{}
+	_ datasource.DataSourceWithConfigure = &taskDataSource{}
+)
+
+// taskDataSourceModel maps the datasource schema
+type taskDataSourceModel struct {
+	Id                types.String    `tfsdk:"id"`
+	WaitForCompletion types.Bool      `tfsdk:"wait_for_completion"`
+	Timeout           types.String    `tfsdk:"timeout"`
+	TaskType          types.String    `tfsdk:"task_type"`
+	TaskName          types.String    `tfsdk:"task_name"`
+	Status            types.String    `tfsdk:"status"`
+	ResourceId        types.String    `tfsdk:"resource_id"`
+	ResourceName      types.String    `tfsdk:"resource_name"`
+	ServiceType       types.String    `tfsdk:"service_type"`
+	ErrorMessage      types.String    `tfsdk:"error_message"`
+	LastStep          types.String    `tfsdk:"last_step"`
+	Created           types.String    `tfsdk:"created"`
+	Modified          types.String    `tfsdk:"modified"`
+	TimeStarted       types.String    `tfsdk:"time_started"`
+	TimeCompleted     types.String    `tfsdk:"time_completed"`
+	Steps             []taskStepModel `tfsdk:"steps"`
+}
+
+type taskStepModel struct {
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	ErrorMessage  types.String `tfsdk:"error_message"`
+	TimeStarted   types.String `tfsdk:"time_started"`
+	TimeCompleted types.String `tfsdk:"time_completed"`
+}
+
+func NewTaskDataSource() datasource.DataSource {
+	return &taskDataSource{}
+}
+
+type taskDataSource struct {
+	client *tdh.Client
+}
+
+// Metadata returns the data source type name.
+func (d *taskDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_task"
+}
+
+// Schema defines the schema for the data source.
+func (d *taskDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Used to fetch a task by ID, and optionally wait for it to complete. Useful to wait for operations triggered outside of terraform.\n" +
+			"**Note:** A failed task doesn't fail the read, check `status` & `error_message` to act on it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the task.",
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set to `true`, waits until the task is either successful or failed.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Maximum time to wait for the task to complete, like `30s`, `10m` or `1h`. "+
+					"Used only when `wait_for_completion` is `true`. Default is `%s`.", defaultTaskWaitTimeout),
+				Validators: []validator.String{
+					validators.DurationValidator{},
+				},
+			},
+			"task_type": schema.StringAttribute{
+				Description: "Type of the task.",
+				Computed:    true,
+			},
+			"task_name": schema.StringAttribute{
+				Description: "Name of the task.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the task.",
+				Computed:    true,
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "ID of the resource related to this task.",
+				Computed:    true,
+			},
+			"resource_name": schema.StringAttribute{
+				Description: "Name of the resource related to this task.",
+				Computed:    true,
+			},
+			"service_type": schema.StringAttribute{
+				Description: "Service type of the resource related to this task.",
+				Computed:    true,
+			},
+			"error_message": schema.StringAttribute{
+				Description: "Reason of failure, if the task has failed.",
+				Computed:    true,
+			},
+			"last_step": schema.StringAttribute{
+				Description: "Name of the last step the task has reached.",
+				Computed:    true,
+			},
+			"created": schema.StringAttribute{
+				Description: "Creation time of the task.",
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Time when the task was last modified.",
+				Computed:    true,
+			},
+			"time_started": schema.StringAttribute{
+				Description: "Time when the task was started.",
+				Computed:    true,
+			},
+			"time_completed": schema.StringAttribute{
+				Description: "Time when the task was completed.",
+				Computed:    true,
+			},
+			"steps": schema.ListNestedAttribute{
+				Description: "Steps of the task.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the step.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the step.",
+							Computed:    true,
+						},
+						"error_message": schema.StringAttribute{
+							Description: "Reason of failure, if the step has failed.",
+							Computed:    true,
+						},
+						"time_started": schema.StringAttribute{
+							Description: "Time when the step was started.",
+							Computed:    true,
+						},
+						"time_completed": schema.StringAttribute{
+							Description: "Time when the step was completed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *taskDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*tdh.Client)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *taskDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state taskDataSourceModel
+
+	// Read Terraform configuration data into the model
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskId := state.Id.ValueString()
+	if state.WaitForCompletion.ValueBool() {
+		timeout := defaultTaskWaitTimeout
+		if !state.Timeout.IsNull() {
+			timeout, _ = time.ParseDuration(state.Timeout.ValueString())
+		}
+		tflog.Info(ctx, "waiting for task", map[string]interface{}{"taskId": taskId, "timeout": timeout.String()})
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := utils.WaitForTask(waitCtx, d.client, taskId)
+		cancel()
+		if err != nil {
+			var taskErr *utils.TaskFailedError
+			if !errors.As(err, &taskErr) {
+				if utils.IsInterrupted(err) && ctx.Err() == nil {
+					resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Waiting for Task",
+						fmt.Sprintf("Task [ID: %s] did not complete within %s", taskId, timeout))
+					return
+				}
+				resp.Diagnostics.AddError("Waiting for Task", err.Error())
+				return
+			}
+		}
+	}
+
+	response, err := d.client.TaskService.GetTask(taskId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Task",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "READING task", map[string]interface{}{
+		"task": response,
+	})
+	d.convertToTfModel(response, &state)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *taskDataSource) convertToTfModel(response *model.Task, state *taskDataSourceModel) {
+	var taskName = response.TaskType
+	if response.DisplayName != "" {
+		taskName = response.DisplayName
+	}
+	state.TaskType = types.StringValue(response.TaskType)
+	state.TaskName = types.StringValue(taskName)
+	state.Status = types.StringValue(response.Status)
+	state.ResourceId = types.StringValue(response.UiParams.ResourceId)
+	state.ResourceName = types.StringValue(response.UiParams.ResourceName)
+	state.ServiceType = types.StringValue(response.UiParams.ServiceType)
+	state.ErrorMessage = types.StringValue(response.FailureReason())
+	state.LastStep = types.StringNull()
+	if step := response.LastStep(); step != nil {
+		state.LastStep = types.StringValue(step.Name)
+	}
+	state.Created = types.StringValue(response.Created)
+	state.Modified = types.StringValue(response.Modified)
+	state.TimeStarted = types.StringValue(response.TimeStarted)
+	state.TimeCompleted = types.StringValue(response.TimeCompleted)
+	state.Steps = make([]taskStepModel, 0, len(response.Steps))
+	for _, step := range response.Steps {
+		state.Steps = append(state.Steps, taskStepModel{
+			Name:          types.StringValue(step.Name),
+			Status:        types.StringValue(step.Status),
+			ErrorMessage:  types.StringValue(step.ErrorMessage),
+			TimeStarted:   types.StringValue(step.TimeStarted),
+			TimeCompleted: types.StringValue(step.TimeCompleted),
+		})
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/task"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/utils"
 	"github.com/svc-bot-mds/terraform-provider-tdh/constants/common"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+	"time"
 )
 
 var (
@@ -22,17 +24,35 @@ var (
 
 // tasksDataSourceModel maps the datasource schema
 type tasksDataSourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	ResourceName types.String `tfsdk:"resource_name"`
-	List         []taskModel  `tfsdk:"list"`
+	Id             types.String `tfsdk:"id"`
+	ResourceName   types.String `tfsdk:"resource_name"`
+	ResourceId     types.String `tfsdk:"resource_id"`
+	Status         types.String `tfsdk:"status"`
+	TaskType       types.String `tfsdk:"task_type"`
+	ServiceType    types.String `tfsdk:"service_type"`
+	CreatedAfter   types.String `tfsdk:"created_after"`
+	CreatedBefore  types.String `tfsdk:"created_before"`
+	ModifiedAfter  types.String `tfsdk:"modified_after"`
+	ModifiedBefore types.String `tfsdk:"modified_before"`
+	MostRecent     types.Bool   `tfsdk:"most_recent"`
+	List           []taskModel  `tfsdk:"list"`
 }
 
 type taskModel struct {
 	Id           types.String `tfsdk:"id"`
 	Status       types.String `tfsdk:"status"`
 	TaskName     types.String `tfsdk:"task_name"`
+	TaskType     types.String `tfsdk:"task_type"`
 	ResourceName types.String `tfsdk:"resource_name"`
 	ResourceId   types.String `tfsdk:"resource_id"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	Created      types.String `tfsdk:"created"`
+	Modified     types.String `tfsdk:"modified"`
+}
+
+// taskTimeWindow holds the optional bounds of the time filters.
+type taskTimeWindow struct {
+	createdAfter, createdBefore, modifiedAfter, modifiedBefore *time.Time
 }
 
 func NewTasksDataSource() datasource.DataSource {
@@ -51,8 +71,8 @@ func (d *tasksDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 // Schema defines the schema for the data source.
 func (d *tasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Used to fetch running/completed task(s).\n" +
-			"**Note:** At least one of `id` or `resource_name` is required; when both are present, preference will be given to `id`.",
+		MarkdownDescription: "Used to fetch running/completed task(s), most recently modified first.\n" +
+			"**Note:** At least one of `id` or the filters is required; when `id` is present, filters are ignored.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Optional:            true,
@@ -65,6 +85,55 @@ func (d *tasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Optional:            true,
 				MarkdownDescription: "Name of the resource to filter tasks by.",
 			},
+			"resource_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the resource to filter tasks by.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Status to filter tasks by. Ex: `SUCCESS`, `FAILED`.",
+			},
+			"task_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Type of the task to filter tasks by.",
+			},
+			"service_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Service type of the related resource to filter tasks by.",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only tasks created at or after this time (RFC3339) are fetched.",
+				Validators: []validator.String{
+					validators.RFC3339Validator{},
+				},
+			},
+			"created_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only tasks created before this time (RFC3339) are fetched.",
+				Validators: []validator.String{
+					validators.RFC3339Validator{},
+				},
+			},
+			"modified_after": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only tasks modified at or after this time (RFC3339) are fetched. " +
+					"Ex: `timeadd(timestamp(), \"-24h\")` to get the tasks of the last day.",
+				Validators: []validator.String{
+					validators.RFC3339Validator{},
+				},
+			},
+			"modified_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only tasks modified before this time (RFC3339) are fetched.",
+				Validators: []validator.String{
+					validators.RFC3339Validator{},
+				},
+			},
+			"most_recent": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set to `true`, only the most recently modified task matching the filters is fetched.",
+			},
 			"list": schema.ListNestedAttribute{
 				Description: "List of tasks.",
 				Computed:    true,
@@ -78,6 +147,10 @@ func (d *tasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Description: "Name of the task.",
 							Computed:    true,
 						},
+						"task_type": schema.StringAttribute{
+							Description: "Type of the task.",
+							Computed:    true,
+						},
 						"status": schema.StringAttribute{
 							Description: "Status of the task.",
 							Computed:    true,
@@ -92,6 +165,18 @@ func (d *tasksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Computed:    true,
 							Optional:    true,
 						},
+						"error_message": schema.StringAttribute{
+							Description: "Reason of failure, if the task has failed.",
+							Computed:    true,
+						},
+						"created": schema.StringAttribute{
+							Description: "Creation time of the task.",
+							Computed:    true,
+						},
+						"modified": schema.StringAttribute{
+							Description: "Time when the task was last modified.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -135,12 +220,22 @@ func (d *tasksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 }
 
 func (d *tasksDataSource) validateInputs(state tasksDataSourceModel, diag *diag.Diagnostics) *diag.Diagnostics {
-	if state.Id.IsNull() && state.ResourceName.IsNull() {
-		diag.AddError(
-			"Invalid input",
-			"At least one of `id` or `resource_name` is required",
-		)
+	filters := []types.String{
+		state.ResourceName, state.ResourceId, state.Status, state.TaskType, state.ServiceType,
+		state.CreatedAfter, state.CreatedBefore, state.ModifiedAfter, state.ModifiedBefore,
+	}
+	if !state.Id.IsNull() {
+		return diag
 	}
+	for _, filter := range filters {
+		if !filter.IsNull() {
+			return diag
+		}
+	}
+	diag.AddError(
+		"Invalid input",
+		"At least one of `id` or the filters is required",
+	)
 	return diag
 }
 
@@ -167,26 +262,58 @@ func (d *tasksDataSource) populateByFilters(ctx *context.Context, state *tasksDa
 	tflog.Info(*ctx, "populating by filters: %s", map[string]interface{}{"state": state})
 	query := &task.TasksQuery{
 		ResourceName: state.ResourceName.ValueString(),
+		ResourceId:   state.ResourceId.ValueString(),
+		Status:       state.Status.ValueString(),
+		TaskType:     state.TaskType.ValueString(),
+		ServiceType:  state.ServiceType.ValueString(),
 	}
-	response, err := d.client.TaskService.GetTasks(query)
-	if err != nil {
-		diag.AddError(
-			"Unable to Read Task(s)",
-			err.Error(),
-		)
-		return
+	window := taskTimeWindow{
+		createdAfter:   parseOptionalTime(state.CreatedAfter),
+		createdBefore:  parseOptionalTime(state.CreatedBefore),
+		modifiedAfter:  parseOptionalTime(state.ModifiedAfter),
+		modifiedBefore: parseOptionalTime(state.ModifiedBefore),
 	}
-
-	// Map DTO body to model
-	for _, taskDto := range *response.Get() {
-		tflog.Debug(*ctx, "READING task", map[string]interface{}{
-			"task": taskDto,
-		})
-		tfModel := d.convertToTfModel(taskDto)
-		tfModel.ResourceName = state.ResourceName
-		state.List = append(state.List, tfModel)
+	// sorted by creation time when only those bounds are set, paging stops at the first task created before them
+	sortedByCreated := window.createdAfter != nil && window.modifiedAfter == nil && window.modifiedBefore == nil && !state.MostRecent.ValueBool()
+	if sortedByCreated {
+		query.Sort = "created,DESC"
 	}
+	state.List = []taskModel{}
+	for {
+		response, err := d.client.TaskService.GetTasks(query)
+		if err != nil {
+			diag.AddError(
+				"Unable to Read Task(s)",
+				err.Error(),
+			)
+			return
+		}
 
+		// Map DTO body to model
+		for _, taskDto := range *response.Get() {
+			// pages are sorted by time, so nothing further can be within the window
+			if window.isBeforeStart(&taskDto, sortedByCreated) {
+				return
+			}
+			if !window.contains(&taskDto) {
+				continue
+			}
+			tflog.Debug(*ctx, "READING task", map[string]interface{}{
+				"task": taskDto,
+			})
+			state.List = append(state.List, d.convertToTfModel(taskDto))
+			if state.MostRecent.ValueBool() {
+				return
+			}
+		}
+
+		nextPage := utils.GetNextPageInfo(response.GetPage())
+		if nextPage == nil {
+			return
+		}
+		nextPage.Sort = query.Sort
+		query.PageQuery = *nextPage
+	}
 }
 
 func (d *tasksDataSource) convertToTfModel(response model.Task) taskModel {
@@ -195,9 +322,13 @@ func (d *tasksDataSource) convertToTfModel(response model.Task) taskModel {
 		taskName = response.DisplayName
 	}
 	tfModel := taskModel{
-		Id:       types.StringValue(response.Id),
-		TaskName: types.StringValue(taskName),
-		Status:   types.StringValue(response.Status),
+		Id:           types.StringValue(response.Id),
+		TaskName:     types.StringValue(taskName),
+		TaskType:     types.StringValue(response.TaskType),
+		Status:       types.StringValue(response.Status),
+		ErrorMessage: types.StringValue(response.FailureReason()),
+		Created:      types.StringValue(response.Created),
+		Modified:     types.StringValue(response.Modified),
 	}
 	if response.UiParams.ResourceName != "" {
 		tfModel.ResourceName = types.StringValue(response.UiParams.ResourceName)
@@ -207,3 +338,45 @@ func (d *tasksDataSource) convertToTfModel(response model.Task) taskModel {
 	}
 	return tfModel
 }
+
+// contains returns false if any of the task timestamps is outside the configured bounds.
+// Tasks whose timestamps can't be parsed are kept, as they can't be judged.
+func (w *taskTimeWindow) contains(task *model.Task) bool {
+	if created, err := time.Parse(time.RFC3339, task.Created); err == nil {
+		if (w.createdAfter != nil && created.Before(*w.createdAfter)) || (w.createdBefore != nil && !created.Before(*w.createdBefore)) {
+			return false
+		}
+	}
+	if modified, err := time.Parse(time.RFC3339, task.Modified); err == nil {
+		if (w.modifiedAfter != nil && modified.Before(*w.modifiedAfter)) || (w.modifiedBefore != nil && !modified.Before(*w.modifiedBefore)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isBeforeStart returns true if the task, & so the ones on further pages, is before the start of window.
+// Pages are sorted by creation time or else modification time, a task is never modified before it's created.
+func (w *taskTimeWindow) isBeforeStart(task *model.Task, sortedByCreated bool) bool {
+	timestamp := task.Modified
+	if sortedByCreated {
+		timestamp = task.Created
+	}
+	parsed, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return false
+	}
+	return (w.createdAfter != nil && parsed.Before(*w.createdAfter)) || (w.modifiedAfter != nil && parsed.Before(*w.modifiedAfter))
+}
+
+// parseOptionalTime returns nil for null values, the values are expected to be validated already.
+func parseOptionalTime(value types.String) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
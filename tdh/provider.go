@@ -11,6 +11,7 @@ import (
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
 	"os"
 	"strings"
 
@@ -222,6 +223,7 @@ func (p *tdhProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewServiceRolesDatasource,
 		NewCloudAccountsDatasource,
 		NewProviderTypesDataSource,
+		NewServiceTypesDataSource,
 		NewCertificatesDatasource,
 		NewDnsDatasource,
 		NewSmtpDatasource,
@@ -231,6 +233,7 @@ func (p *tdhProvider) DataSources(_ context.Context) []func() datasource.DataSou
 		NewK8sClustersDatasource,
 		NewObjectStorageDatasource,
 		NewTasksDataSource,
+		NewTaskDataSource,
 		NewLocalUsersDataSource,
 		NewBackupDataSource,
 		NewRestoresDataSource,
@@ -259,12 +262,20 @@ func (p *tdhProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewObjectStorageResource,
 		NewLocalUserResource,
 		NewClusterBackupResource,
+		NewRabbitMqVhostResource,
+		NewRabbitMqQueueResource,
+		NewRabbitMqExchangeResource,
+		NewRabbitMqBindingResource,
+		NewClusterDatabaseResource,
+		NewClusterSchemaResource,
+		NewClusterRestoreResource,
 	}
 }
 
+// supportedServiceTypesMarkdown lists the service types known to this version, more may be enabled on TDH.
 func supportedServiceTypesMarkdown() string {
 	var sb strings.Builder
-	serviceTypes := service_type.GetAll()
+	serviceTypes := utils.KnownServiceTypeNames()
 	sb.WriteString(fmt.Sprintf("`%s`", serviceTypes[0]))
 	for _, serviceType := range serviceTypes[1:] {
 		sb.WriteString(fmt.Sprintf(", `%s`", serviceType))
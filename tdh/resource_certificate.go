@@ -271,6 +271,13 @@ func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest
 	// Get refreshed certificate value from TDH
 	certificate, err := r.client.InfraConnector.GetCertificate(state.ID.ValueString())
 	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "certificate not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading TDH certificate",
 			"Could not read TDH certificate "+state.ID.ValueString()+": "+err.Error(),
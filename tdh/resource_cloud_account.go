@@ -305,6 +305,13 @@ func (r *cloudAccountResource) Read(ctx context.Context, req resource.ReadReques
 	// Get refreshed cloud account value from TDH
 	cloudAcct, err := r.client.InfraConnector.GetCloudAccount(state.ID.ValueString())
 	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "cloud account not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading TDH cloud account",
 			"Could not read TDH cloud account ID "+state.ID.ValueString()+": "+err.Error(),
@@ -2,7 +2,11 @@ package tdh
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -10,24 +14,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/cluster_status"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/policy_type"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/task_status"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/customer-metadata"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/infra-connector"
 	upgrade_service "github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/upgrade-service"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+	"golang.org/x/crypto/bcrypt"
+	"os"
 	"regexp"
-	"strconv"
+	"slices"
 	"strings"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,6 +52,7 @@ var (
 	_ resource.Resource                = &clusterResource{}
 	_ resource.ResourceWithConfigure   = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 )
 
 func NewClusterResource() resource.Resource {
@@ -47,32 +65,64 @@ type clusterResource struct {
 
 // clusterResourceModel maps the resource schema data.
 type clusterResourceModel struct {
-	ID                types.String          `tfsdk:"id"`
-	OrgId             types.String          `tfsdk:"org_id"`
-	Name              types.String          `tfsdk:"name"`
-	ServiceType       types.String          `tfsdk:"service_type"`
-	Provider          types.String          `tfsdk:"provider_type"`
-	InstanceSize      types.String          `tfsdk:"instance_size"`
-	Region            types.String          `tfsdk:"region"`
-	Tags              types.Set             `tfsdk:"tags"`
-	NetworkPolicyIds  types.Set             `tfsdk:"network_policy_ids"`
-	Dedicated         types.Bool            `tfsdk:"dedicated"`
-	Shared            types.Bool            `tfsdk:"shared"`
-	Status            types.String          `tfsdk:"status"`
-	DataPlaneId       types.String          `tfsdk:"data_plane_id"`
-	LastUpdated       types.String          `tfsdk:"last_updated"`
-	Created           types.String          `tfsdk:"created"`
-	Metadata          types.Object          `tfsdk:"metadata"`
-	Version           types.String          `tfsdk:"version"`
-	StoragePolicyName types.String          `tfsdk:"storage_policy_name"`
-	ClusterMetadata   *clusterMetadataModel `tfsdk:"cluster_metadata"`
-	Upgrade           *upgradeMetadata      `tfsdk:"upgrade"`
+	ID                 types.String          `tfsdk:"id"`
+	OrgId              types.String          `tfsdk:"org_id"`
+	Name               types.String          `tfsdk:"name"`
+	ServiceType        types.String          `tfsdk:"service_type"`
+	Provider           types.String          `tfsdk:"provider_type"`
+	InstanceSize       types.String          `tfsdk:"instance_size"`
+	Region             types.String          `tfsdk:"region"`
+	Tags               types.Set             `tfsdk:"tags"`
+	NetworkPolicyIds   types.Set             `tfsdk:"network_policy_ids"`
+	Dedicated          types.Bool            `tfsdk:"dedicated"`
+	Shared             types.Bool            `tfsdk:"shared"`
+	Status             types.String          `tfsdk:"status"`
+	DataPlaneId        types.String          `tfsdk:"data_plane_id"`
+	LastUpdated        types.String          `tfsdk:"last_updated"`
+	Created            types.String          `tfsdk:"created"`
+	Metadata           types.Object          `tfsdk:"metadata"`
+	Version            types.String          `tfsdk:"version"`
+	StoragePolicyName  types.String          `tfsdk:"storage_policy_name"`
+	ClusterMetadata    *clusterMetadataModel `tfsdk:"cluster_metadata"`
+	Upgrade            *upgradeMetadata      `tfsdk:"upgrade"`
+	MaintenanceWindow  types.Object          `tfsdk:"maintenance_window"`
+	PauseUpdates       types.Bool            `tfsdk:"pause_updates"`
+	Connection         types.Object          `tfsdk:"connection"`
+	Placement          *placementModel       `tfsdk:"placement"`
+	SourceBackupId     types.String          `tfsdk:"source_backup_id"`
+	SourceClusterId    types.String          `tfsdk:"source_cluster_id"`
+	MostRecentBackup   types.Bool            `tfsdk:"most_recent_backup"`
+	DeletionProtection types.Bool            `tfsdk:"deletion_protection"`
+	FinalBackup        types.Bool            `tfsdk:"final_backup"`
+	WaitForReady       types.Bool            `tfsdk:"wait_for_ready"`
+	AsyncCreate        types.Bool            `tfsdk:"async_create"`
+	Parameters         types.Map             `tfsdk:"parameters"`
 }
 
+// placementModel maps the criteria for choosing the data plane of cluster.
+type placementModel struct {
+	Provider      types.String `tfsdk:"provider"`
+	Region        types.String `tfsdk:"region"`
+	Dedicated     types.Bool   `tfsdk:"dedicated"`
+	Tags          types.Set    `tfsdk:"tags"`
+	StoragePolicy types.String `tfsdk:"storage_policy"`
+}
+
+// successfulBackupStatuses & failedBackupStatuses are the final statuses of a backup.
+var (
+	successfulBackupStatuses = []string{"Completed", "Succeeded"}
+	failedBackupStatuses     = []string{"Failed", "PartiallyFailed"}
+)
+
+// systemDatabases are the databases present on every cluster, never the one created along with it.
+var systemDatabases = []string{"postgres", "template0", "template1", "mysql", "sys", "information_schema", "performance_schema"}
+
 // clusterMetadataModel maps order item data.
 type clusterMetadataModel struct {
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
+	PasswordEnv   types.String `tfsdk:"password_env"`
+	PasswordHash  types.String `tfsdk:"password_hash"`
 	Database      types.String `tfsdk:"database"`
 	Extensions    types.Set    `tfsdk:"extensions"`
 	ObjectStoreId types.String `tfsdk:"object_storage_id"`
@@ -88,6 +138,42 @@ type upgradeMetadata struct {
 	OmitBackup types.Bool `tfsdk:"omit_backup"`
 }
 
+// maintenanceWindowModel maps the weekly window in which cluster can be updated.
+type maintenanceWindowModel struct {
+	Day      types.String `tfsdk:"day"`
+	Time     types.String `tfsdk:"time"`
+	Duration types.String `tfsdk:"duration"`
+}
+
+// connectionModel maps the details for connecting to the cluster.
+type connectionModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Database types.String `tfsdk:"database"`
+	Uri      types.String `tfsdk:"uri"`
+	JdbcUrl  types.String `tfsdk:"jdbc_url"`
+	Dsn      types.String `tfsdk:"dsn"`
+}
+
+var connectionAttrTypes = map[string]attr.Type{
+	"host":     types.StringType,
+	"port":     types.Int64Type,
+	"username": types.StringType,
+	"password": types.StringType,
+	"database": types.StringType,
+	"uri":      types.StringType,
+	"jdbc_url": types.StringType,
+	"dsn":      types.StringType,
+}
+
+var maintenanceWindowAttrTypes = map[string]attr.Type{
+	"day":      types.StringType,
+	"time":     types.StringType,
+	"duration": types.StringType,
+}
+
 func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cluster"
 }
@@ -116,8 +202,11 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Represents a service instance or cluster. Some attributes are used only once for creation, they are: `dedicated`, `network_policy_ids`, `cluster_metadata`.\n" +
-			"Changing only `tags` is supported at the moment. If you wish to update network policies associated with it, please refer resource: " +
-			"`tdh_cluster_network_policies_association`.",
+			"Changing `tags`, `version`, `instance_size`, `maintenance_window`, `pause_updates`, `parameters`, `cluster_metadata.password` & `cluster_metadata.extensions` is supported at the moment. Changing any of `name`, `service_type`, `provider_type`, `region`, `data_plane_id`, " +
+			"`storage_policy_name`, `dedicated`, `shared`, `source_backup_id`, `source_cluster_id` & `cluster_metadata` (except `password` & `extensions`) will force the cluster to be replaced. If you wish to update network policies associated with it, please refer resource: " +
+			"`tdh_cluster_network_policies_association`. Setting any of these on an imported cluster, where they are unknown, is adopted on its first apply instead.\n" +
+			"Instance size, version, region, data plane & storage policy are validated against the ones offered, while planning.\n" +
+			"To clone a cluster, create it from a backup using either `source_backup_id`, or `source_cluster_id` with `most_recent_backup`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID of the cluster.",
@@ -144,8 +233,8 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"service_type": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("Type of TDH Cluster to be created. Supported values: %s.\n"+
-					"Default is `POSTGRES`.", supportedServiceTypesMarkdown()),
+				MarkdownDescription: fmt.Sprintf("Type of TDH Cluster to be created, one of the service types enabled on TDH as listed by datasource `tdh_service_types`, like: %s.\n"+
+					"Default is `POSTGRES`. Service type & what it supports are validated while planning.", supportedServiceTypesMarkdown()),
 				Optional: true,
 				Computed: true,
 				Default:  stringdefault.StaticString(service_type.POSTGRES),
@@ -154,19 +243,23 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"provider_type": schema.StringAttribute{
-				MarkdownDescription: "Short-code of provider to use for data-plane. Ex: `tkgs`, `tkgm` . Complete list can be seen using datasource `tdh_provider_types`.",
-				Required:            true,
+				MarkdownDescription: "Short-code of provider to use for data-plane. Ex: `tkgs`, `tkgm` . Complete list can be seen using datasource `tdh_provider_types`. " +
+					"Either this or `placement` is required.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("placement")),
 				},
 			},
 			"instance_size": schema.StringAttribute{
 				MarkdownDescription: "Size of instance. Supported values: `XX-SMALL`, `X-SMALL`, `SMALL`, `LARGE`, `XX-LARGE`." +
 					"\nPlease make use of datasource `tdh_network_ports` to decide on a size based on resources it requires." +
-					"\n`SMALL-LITE` instance size is applicable only for 'POSTGRES' service type",
+					"\nSizes available for each service type can be fetched using datasource `tdh_instance_types`." +
+					"\nChanging it resizes the cluster in-place, sizes whose storage is less than what the cluster currently uses are refused.",
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -176,14 +269,16 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"region": schema.StringAttribute{
-				MarkdownDescription: "Region of data plane. Available values can be seen using datasource `tdh_regions`.",
-				Required:            true,
+				MarkdownDescription: "Region of data plane. Available values can be seen using datasource `tdh_regions`. Either this or `placement` is required.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(2),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("placement")),
 				},
 			},
 			"dedicated": schema.BoolAttribute{
@@ -221,11 +316,16 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"data_plane_id": schema.StringAttribute{
-				Description: "ID of the data-plane where the cluster is running.",
-				Required:    true,
+				MarkdownDescription: "ID of the data-plane where the cluster is running. Either this or `placement` is required.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 					validators.UUIDValidator{},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("placement")),
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -242,7 +342,7 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 			"version": schema.StringAttribute{
 				MarkdownDescription: "Version of the cluster.\n" +
 					"#### Notes:\n" +
-					"- Changing version will result in cluster upgrade process. Use datasource `tdh_cluster_target_versions` to get next versions.\n" +
+					"- Changing version will result in cluster upgrade process. Use datasource `tdh_cluster_target_versions` to get next versions, any other version is refused while planning.\n" +
 					"- To specify extra options for cluster upgrade, please make use of ['upgrade' attribute](#nestedatt--upgrade).",
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -253,14 +353,44 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"storage_policy_name": schema.StringAttribute{
-				Description: "Name of the storage policy for the cluster.",
-				Required:    true,
+				MarkdownDescription: "Name of the storage policy for the cluster. Either this or `placement` is required.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 					validators.EmptyStringValidator{},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("placement")),
+				},
+			},
+			"source_backup_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the backup to create the cluster from, cloning its data. When `source_cluster_id` is used instead, this is the ID of the backup it resolved to. " +
+					"Supported for `POSTGRES` & `MYSQL`, the backup must be completed & of the same service type. Configured `extensions` are enabled once the backup is restored.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+					stringvalidator.ConflictsWith(path.MatchRoot("source_cluster_id")),
+				},
+			},
+			"source_cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the cluster whose backup to create the cluster from, cloning its data. Requires `most_recent_backup` to be `true`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("most_recent_backup")),
+				},
+			},
+			"most_recent_backup": schema.BoolAttribute{
+				MarkdownDescription: "Whether to use the most recent completed backup of `source_cluster_id`. The backup is resolved only while creating the cluster.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("source_cluster_id")),
 				},
 			},
 			"metadata": schema.SingleNestedAttribute{
@@ -308,8 +438,88 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"placement": schema.SingleNestedAttribute{
+				MarkdownDescription: "Criteria for choosing the data plane of the cluster, instead of specifying `provider_type`, `region`, `data_plane_id` & `storage_policy_name`. " +
+					"Among the eligible data planes matching it, the ones offering the preferred storage policy are chosen first, then the one first by name & ID. " +
+					"Chosen values are saved in the state & changing the criteria may choose a different data plane, forcing the cluster to be replaced.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"provider": schema.StringAttribute{
+						MarkdownDescription: "Short-code of provider of the data plane. Ex: `tkgs`, `tkgm` . Complete list can be seen using datasource `tdh_provider_types`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region of the data plane. If not specified, data planes of all regions are considered.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(2),
+						},
+					},
+					"dedicated": schema.BoolAttribute{
+						MarkdownDescription: "Whether to choose from the data planes dedicated to current Org, instead of shared ones.",
+						Optional:            true,
+					},
+					"tags": schema.SetAttribute{
+						MarkdownDescription: "Tags the data plane must have.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"storage_policy": schema.StringAttribute{
+						MarkdownDescription: "Preferred storage policy. If chosen data plane doesn't offer it, the first of its storage policies by name is used.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"connection": schema.SingleNestedAttribute{
+				MarkdownDescription: "Details for connecting to the cluster, built from its connection URI & the credentials in `cluster_metadata`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Host of the cluster.",
+						Computed:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "Port on which the service listens.",
+						Computed:            true,
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Username to connect with.",
+						Computed:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password to connect with.",
+						Computed:            true,
+					},
+					"database": schema.StringAttribute{
+						MarkdownDescription: "Database to connect to.",
+						Computed:            true,
+					},
+					"uri": schema.StringAttribute{
+						MarkdownDescription: "Connection URI, as expected by clients of the service: `postgresql://` for `POSTGRES`, `mysql://` for `MYSQL`, `redis://` for `REDIS` & `amqp://` for `RABBITMQ`.",
+						Computed:            true,
+					},
+					"jdbc_url": schema.StringAttribute{
+						MarkdownDescription: "JDBC URL *(Specific to services: `POSTGRES` & `MYSQL`)*.",
+						Computed:            true,
+					},
+					"dsn": schema.StringAttribute{
+						MarkdownDescription: "libpq keyword/value connection string *(Specific to service: `POSTGRES`)*.",
+						Computed:            true,
+					},
+				},
+			},
 			"cluster_metadata": schema.SingleNestedAttribute{
-				MarkdownDescription: fmt.Sprintf("Additional info for the cluster. Required for services: %s.", supportedDataServiceTypesMarkdown()),
+				MarkdownDescription: fmt.Sprintf("Additional info for the cluster. Required for services needing credentials, like: %s.", supportedDataServiceTypesMarkdown()),
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"username": schema.StringAttribute{
@@ -321,11 +531,27 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 						},
 					},
 					"password": schema.StringAttribute{
-						Description: "Password for the cluster.",
-						Required:    true,
+						MarkdownDescription: "Password for the cluster. Changing it rotates the password of the cluster. Either this or `password_env` is required.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: append([]validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_env")),
+						}, clusterPasswordValidators...),
+					},
+					"password_env": schema.StringAttribute{
+						MarkdownDescription: "Name of the environment variable holding password for the cluster, read while planning & applying. " +
+							"Password itself is never saved in the state, only its bcrypt hash is, so changing the value of variable rotates the password of the cluster. " +
+							"Password read is validated same as `password`. `connection` won't contain the password in this mode.",
+						Optional: true,
 						Validators: []validator.String{
-							stringvalidator.LengthBetween(8, 24),
-							validators.PasswordValidator{},
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"password_hash": schema.StringAttribute{
+						MarkdownDescription: "Bcrypt hash of the password read from `password_env`, used to detect its change. Not set when `password` is used.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
 					"database": schema.StringAttribute{
@@ -334,7 +560,7 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 						Optional:            true,
 					},
 					"extensions": schema.SetAttribute{
-						MarkdownDescription: "Set of extensions (`name`) to be enabled on the cluster *(Specific to service: `POSTGRES`)*. Available values can be fetched using datasource `tdh_service_extensions`.",
+						MarkdownDescription: "Set of extensions (`name`) to be enabled on the cluster *(Specific to service: `POSTGRES`)*. Available values can be fetched using datasource `tdh_service_extensions`, extensions can be enabled or disabled after creation too. When not set, extensions enabled on the cluster are left as they are.",
 						Optional:            true,
 						ElementType:         types.StringType,
 					},
@@ -347,13 +573,88 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 					},
 				},
 			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "Weekly window (in UTC) in which the cluster can be updated. Version upgrades are refused outside of it, while planning & applying.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"day": schema.StringAttribute{
+						MarkdownDescription: "Day of the week on which window starts. Supported values: `MONDAY`, `TUESDAY`, `WEDNESDAY`, `THURSDAY`, `FRIDAY`, `SATURDAY`, `SUNDAY`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"),
+						},
+					},
+					"time": schema.StringAttribute{
+						MarkdownDescription: "Time of the day (UTC) at which window starts, in format `HH:MM`. Ex: `02:30`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of the day in format HH:MM"),
+						},
+					},
+					"duration": schema.StringAttribute{
+						MarkdownDescription: "Length of the window. Ex: `4h`, `1h30m`.",
+						Required:            true,
+						Validators: []validator.String{
+							validators.DurationValidator{},
+						},
+					},
+				},
+			},
+			"pause_updates": schema.BoolAttribute{
+				MarkdownDescription: "Whether updates of the cluster are paused.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster is protected from being deleted, including when a change forces it to be replaced. " +
+					"It has to be set to `false` & applied before the cluster can be deleted. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"final_backup": schema.BoolAttribute{
+				MarkdownDescription: "Whether to take a backup of the cluster before deleting it. The cluster is deleted only once the backup is completed, " +
+					"its ID is reported in a warning. Not applicable for `RABBITMQ`. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "Service specific configuration parameters of the cluster, applied while creating it & updated in-place. " +
+					"Parameters allowed for each service type are listed by datasource `tdh_service_types`, ex: `max_connections` for `POSTGRES`. " +
+					"For `RABBITMQ`, `plugins` is a comma separated list of plugins to enable.\n" +
+					"Only the parameters set here are tracked for changes made outside of Terraform. Removing a parameter stops managing it, leaving its current value on the cluster.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait, after the cluster is created, for its status to be `READY` with connection details available. Default is `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"async_create": schema.BoolAttribute{
+				MarkdownDescription: "Whether to return right after the request to create the cluster is submitted, useful for provisioning many clusters at once. " +
+					"The created cluster is adopted by a later run once its creation completes, until then its computed attributes remain empty & changes to it are refused. Deleting it waits for the creation to complete first. " +
+					"`wait_for_ready` is ignored when this is `true`. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"upgrade": schema.SingleNestedAttribute{
 				Description: "Use this for specifying extra options for upgrading cluster version.",
 				Required:    false,
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"omit_backup": schema.BoolAttribute{
-						Description: "Whether to take backup before upgrade process. (default is `false`)",
+						Description: "Whether to skip taking backup before upgrade process. When `false`, a fresh backup is taken & must succeed before the cluster is upgraded. (default is `false`)",
 						Optional:    true,
 					},
 				},
@@ -364,265 +665,1659 @@ func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 	tflog.Info(ctx, "END__Schema")
 }
 
-// Create a new resource
-func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	tflog.Info(ctx, "INIT__Create")
-	// Retrieve values from plan
-	var plan clusterResourceModel
-
-	tflog.Info(ctx, "INIT__Fetching plan")
-	diags := req.Plan.Get(ctx, &plan)
-	tflog.Info(ctx, "INIT__Fetched plan")
-
-	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+// ModifyPlan marks the cluster for replacement when any of the attributes that can't be changed in-place is changed.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to plan while deleting
+	if req.Plan.Raw.IsNull() {
 		return
 	}
-
-	if r.validateInputs(&ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+	var state, plan clusterResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Info(ctx, "INIT__Creating req body")
-
-	// Generate API request body from plan
-	clusterRequest := controller.ClusterCreateRequest{
-		Name:              plan.Name.ValueString(),
-		ServiceType:       plan.ServiceType.ValueString(),
-		InstanceSize:      plan.InstanceSize.ValueString(),
-		Provider:          plan.Provider.ValueString(),
-		Region:            plan.Region.ValueString(),
-		Dedicated:         plan.Dedicated.ValueBool(),
-		Shared:            plan.Shared.ValueBool(),
-		DataPlaneId:       plan.DataPlaneId.ValueString(),
-		Version:           plan.Version.ValueString(),
-		StoragePolicyName: plan.StoragePolicyName.ValueString(),
+	if r.planPasswordHash(ctx, &resp.Diagnostics, &req.State, &resp.Plan, &plan); resp.Diagnostics.HasError() {
+		return
 	}
-	if plan.ServiceType.ValueString() != service_type.RABBITMQ {
-		clusterRequest.ClusterMetadata = controller.ClusterMetadata{
-			Username:      plan.ClusterMetadata.Username.ValueString(),
-			Password:      plan.ClusterMetadata.Password.ValueString(),
-			Database:      plan.ClusterMetadata.Database.ValueString(),
-			ObjectStoreId: plan.ClusterMetadata.ObjectStoreId.ValueString(),
+	if !req.State.Raw.IsNull() {
+		if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+			return
+		}
+		// cluster still being created isn't known yet, it's adopted by the first refresh after its creation completes
+		if !req.Plan.Raw.Equal(req.State.Raw) {
+			pendingTask, dgs := utils.GetPendingTask(ctx, req.Private)
+			if resp.Diagnostics.Append(dgs...); resp.Diagnostics.HasError() {
+				return
+			}
+			if pendingTask != nil {
+				resp.Diagnostics.AddError("Updating TDH Cluster",
+					fmt.Sprintf("Cluster %q is still being created by task [ID: %s], it can be changed once the creation completes.",
+						pendingTask.ResourceName, pendingTask.TaskId),
+				)
+				return
+			}
 		}
 	}
-
-	plan.ClusterMetadata.Extensions.ElementsAs(ctx, &clusterRequest.ClusterMetadata.Extensions, true)
-	tflog.Info(ctx, "INIT__Created req body")
-	tflog.Info(ctx, "Creating cluster", map[string]interface{}{
-		"cluster_request": clusterRequest,
-	})
-
-	plan.Tags.ElementsAs(ctx, &clusterRequest.Tags, true)
-	plan.NetworkPolicyIds.ElementsAs(ctx, &clusterRequest.NetworkPolicyIds, true)
-
-	tflog.Info(ctx, "INIT__Submitting request")
-
-	response, err := r.client.Controller.CreateCluster(&clusterRequest)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Submitting request to create cluster",
-			"Could not create cluster, unexpected error: "+err.Error(),
-		)
+	if r.planPlacement(ctx, &resp.Diagnostics, &resp.Plan, &plan, &state); resp.Diagnostics.HasError() {
 		return
 	}
-	if err = utils.WaitForTask(r.client, response.TaskId); err != nil {
-		resp.Diagnostics.AddError("Error in creating cluster",
-			"Task responsible for this operation failed, error: "+err.Error(),
-		)
+
+	serviceType := r.serviceType(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Info(ctx, "INIT__Fetching clusters")
-	clusters, err := r.client.Controller.GetClusters(&controller.ClustersQuery{
-		ServiceType:   clusterRequest.ServiceType,
-		Name:          clusterRequest.Name,
-		FullNameMatch: true,
-		PageQuery: model.PageQuery{
-			Size: 1,
-		},
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Fetching clusters",
-			"Could not fetch clusters by name, unexpected error: "+err.Error(),
-		)
+	if serviceType != nil && plan.FinalBackup.ValueBool() && !serviceType.BackupSupported {
+		resp.Diagnostics.AddAttributeError(path.Root("final_backup"), "Invalid input",
+			fmt.Sprintf("Backups are not supported for service type %q.", serviceType.Name))
 		return
 	}
-
-	if len(*clusters.Get()) <= 0 {
-		resp.Diagnostics.AddError("Fetching clusters",
-			"Unable to fetch the created cluster",
-		)
+	if serviceType != nil && plan.ClusterMetadata != nil && len(plan.ClusterMetadata.Extensions.Elements()) > 0 && !serviceType.ExtensionsSupported {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_metadata").AtName("extensions"), "Invalid input",
+			fmt.Sprintf("Extensions are not supported for service type %q.", serviceType.Name))
 		return
 	}
-
-	// Map response body to schema and populate Computed attribute values
-	createdCluster := &(*clusters.Get())[0]
-	tflog.Info(ctx, "INIT__Saving Response")
-	if r.saveFromResponse(&ctx, &resp.Diagnostics, &plan, createdCluster) != 0 {
-		return
+	if serviceType != nil && !serviceType.CloneSupported {
+		for _, source := range []path.Path{path.Root("source_backup_id"), path.Root("source_cluster_id")} {
+			var value types.String
+			if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, source, &value)...); !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(source, "Invalid input",
+					fmt.Sprintf("Creating a cluster from backup isn't supported for service type %q.", serviceType.Name))
+			}
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// Set state to fully populated data
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if r.validateParameters(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, "END__Create")
-}
-
-// Read resource information
-func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	tflog.Info(ctx, "INIT__Read")
-	// Get current state
-	var state clusterResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// nothing to compare while creating
+	if req.State.Raw.IsNull() {
+		r.validateCatalog(ctx, &resp.Diagnostics, &plan, nil)
 		return
 	}
-
-	tflog.Debug(ctx, "INIT_Read Fetching Cluster from API")
-	// Get refreshed cluster value from TDH
-	cluster, err := r.client.Controller.GetCluster(state.ID.ValueString())
-	tflog.Debug(ctx, "INIT__Read fetched cluster", map[string]interface{}{"dto": cluster})
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Reading TDH Cluster",
-			"Could not read TDH cluster ID "+state.ID.ValueString()+": "+err.Error(),
-		)
+	if r.validateCatalog(ctx, &resp.Diagnostics, &plan, &state); resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Debug(ctx, "INIT__Read converting response")
-	// Overwrite items with refreshed state
-	if r.saveFromResponse(&ctx, &resp.Diagnostics, &state, cluster) != 0 {
-		return
+	if r.client != nil && !plan.Version.IsUnknown() && !state.ID.IsNull() && plan.Version.ValueString() != state.Version.ValueString() {
+		if r.validateTargetVersion(&resp.Diagnostics, state.ID.ValueString(), plan.Version.ValueString()); resp.Diagnostics.HasError() {
+			return
+		}
+		if r.validateMaintenanceWindow(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	// connection is kept from state, unless any of what it's built from changes
+	if r.connectionChanged(ctx, &resp.Diagnostics, &state, &plan) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("connection"), types.ObjectUnknown(connectionAttrTypes))...)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Info(ctx, "END__Read")
+	imported := utils.IsImported(ctx, req.Private)
+	for _, attribute := range r.immutableAttributes(&state, &plan) {
+		if attribute.plan.IsUnknown() || attribute.state.Equal(attribute.plan) {
+			continue
+		}
+		// null in state of an imported cluster means it was never known, so the configured value is adopted
+		if attribute.state.IsNull() && (imported || attribute.plan.Equal(types.BoolValue(false))) {
+			continue
+		}
+		tflog.Info(ctx, "immutable attribute changed, cluster requires replacement", map[string]interface{}{"attribute": attribute.path.String()})
+		resp.RequiresReplace = append(resp.RequiresReplace, attribute.path)
+	}
+	if len(resp.RequiresReplace) > 0 && state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion protection enabled",
+			fmt.Sprintf("Changing %s requires the cluster to be replaced, but it has deletion protection enabled. "+
+				"Set \"deletion_protection\" to false & apply first to allow replacing it.", resp.RequiresReplace[0]),
+		)
+	}
 }
 
-func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	tflog.Info(ctx, "INIT__Update")
-
-	// Retrieve values from plan
-	var plan clusterResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// validateCatalog validates the instance size, version, region, data plane & storage policy against the ones offered,
+// checking only the values being set for the first time or changed. State is nil while creating.
+func (r *clusterResource) validateCatalog(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel, state *clusterResourceModel) {
+	if r.client == nil || plan.ServiceType.IsUnknown() || plan.Provider.IsUnknown() {
 		return
 	}
-
-	// Retrieve current state
-	var state clusterResourceModel
-	diags = req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	changed := func(get func(*clusterResourceModel) attr.Value) bool {
+		planValue := get(plan)
+		return !planValue.IsUnknown() && !planValue.IsNull() && (state == nil || !planValue.Equal(get(state)))
 	}
+	serviceType := plan.ServiceType.ValueString()
 
-	// Detect version change
-	if plan.Version != state.Version {
+	var instanceType *model.InstanceType
+	if !plan.InstanceSize.IsUnknown() {
+		instanceTypes, err := r.client.Controller.GetServiceInstanceTypes(&controller.InstanceTypesQuery{
+			ServiceType: serviceType,
+		})
+		if err != nil {
+			diags.AddError("Validating TDH Cluster", "Could not fetch instance types, unexpected error: "+err.Error())
+			return
+		}
+		allowedSizes := make([]string, 0, len(instanceTypes.InstanceTypes))
+		for i, available := range instanceTypes.InstanceTypes {
+			allowedSizes = append(allowedSizes, available.InstanceSize)
+			if available.InstanceSize == plan.InstanceSize.ValueString() {
+				instanceType = &instanceTypes.InstanceTypes[i]
+			}
+		}
+		if instanceType == nil && changed(func(m *clusterResourceModel) attr.Value { return m.InstanceSize }) {
+			diags.AddAttributeError(path.Root("instance_size"), "Invalid input",
+				fmt.Sprintf("Instance Size %q is not available for Service %q, allowed values: %q", plan.InstanceSize.ValueString(), serviceType, allowedSizes))
+		}
+	}
+
+	// versions of existing clusters are validated against the ones it can be upgraded to
+	if state == nil && changed(func(m *clusterResourceModel) attr.Value { return m.Version }) {
+		versions, err := r.client.Controller.GetServiceVersions(&controller.ServiceVersionsQuery{
+			ServiceType:  serviceType,
+			Provider:     plan.Provider.ValueString(),
+			TemplateType: "CLUSTER",
+			Action:       "CREATE",
+		})
+		if err != nil {
+			diags.AddError("Validating TDH Cluster", "Could not fetch service versions, unexpected error: "+err.Error())
+			return
+		}
+		if !slices.Contains(versions, plan.Version.ValueString()) {
+			diags.AddAttributeError(path.Root("version"), "Invalid input",
+				fmt.Sprintf("Version %q is not available for Service %q, allowed values: %q", plan.Version.ValueString(), serviceType, versions))
+		}
+	}
+
+	if instanceType != nil && (changed(func(m *clusterResourceModel) attr.Value { return m.Region }) ||
+		changed(func(m *clusterResourceModel) attr.Value { return m.DataPlaneId })) {
+		regionsQuery := &infra_connector.DataPlaneRegionsQuery{
+			Provider:  plan.Provider.ValueString(),
+			CPU:       instanceType.CPU,
+			Memory:    instanceType.Memory,
+			Storage:   instanceType.Storage,
+			NodeCount: instanceType.Metadata.Nodes,
+		}
+		if plan.isDedicated() {
+			regionsQuery.OrgId = r.client.Root.OrgId
+		}
+		regions, err := r.client.InfraConnector.GetRegionsWithDataPlanes(regionsQuery)
+		if err != nil {
+			diags.AddError("Validating TDH Cluster", "Could not fetch regions, unexpected error: "+err.Error())
+			return
+		}
+		allowedRegions := make([]string, 0, len(regions))
+		for region := range regions {
+			allowedRegions = append(allowedRegions, region)
+		}
+		slices.Sort(allowedRegions)
+		dataPlaneIds, found := regions[plan.Region.ValueString()]
+		if !plan.Region.IsUnknown() && !found {
+			diags.AddAttributeError(path.Root("region"), "Invalid input",
+				fmt.Sprintf("Region %q has no data plane for Instance Size %q, allowed values: %q", plan.Region.ValueString(), instanceType.InstanceSize, allowedRegions))
+		} else if found && !plan.DataPlaneId.IsUnknown() && !slices.Contains(dataPlaneIds, plan.DataPlaneId.ValueString()) {
+			diags.AddAttributeError(path.Root("data_plane_id"), "Invalid input",
+				fmt.Sprintf("Data plane %q is not eligible in region %q for Instance Size %q, allowed values: %q", plan.DataPlaneId.ValueString(), plan.Region.ValueString(), instanceType.InstanceSize, dataPlaneIds))
+		}
+	}
+
+	if !plan.DataPlaneId.IsUnknown() && (changed(func(m *clusterResourceModel) attr.Value { return m.StoragePolicyName }) ||
+		changed(func(m *clusterResourceModel) attr.Value { return m.DataPlaneId })) {
+		dataPlaneQuery := &infra_connector.EligibleDataPlanesQuery{
+			InfraResourceType: "SHARED",
+			Provider:          plan.Provider.ValueString(),
+		}
+		if plan.isDedicated() {
+			dataPlaneQuery.InfraResourceType = "DEDICATED"
+			dataPlaneQuery.OrgId = r.client.Root.OrgId
+		}
+		dataPlanes, err := r.client.InfraConnector.GetEligibleDataPlanes(dataPlaneQuery)
+		if err != nil {
+			diags.AddError("Validating TDH Cluster", "Could not fetch eligible data planes, unexpected error: "+err.Error())
+			return
+		}
+		for _, dataPlane := range *dataPlanes.Get() {
+			if dataPlane.Id != plan.DataPlaneId.ValueString() {
+				continue
+			}
+			if !plan.StoragePolicyName.IsUnknown() && !slices.Contains(dataPlane.StoragePolicies, plan.StoragePolicyName.ValueString()) {
+				diags.AddAttributeError(path.Root("storage_policy_name"), "Invalid input",
+					fmt.Sprintf("Storage policy %q is not available on data plane %q, allowed values: %q", plan.StoragePolicyName.ValueString(), dataPlane.DataPlaneName, dataPlane.StoragePolicies))
+			}
+			return
+		}
+		tflog.Warn(ctx, "data plane is not among the eligible ones, skipping validation of storage policy", map[string]interface{}{"data_plane_id": plan.DataPlaneId.ValueString()})
+	}
+}
+
+// isDedicated tells if the cluster goes on a dedicated data plane, either requested directly or as placement criteria.
+func (m *clusterResourceModel) isDedicated() bool {
+	return m.Dedicated.ValueBool() || (m.Placement != nil && m.Placement.Dedicated.ValueBool())
+}
+
+func (p *placementModel) equals(other *placementModel) bool {
+	return p.Provider.Equal(other.Provider) && p.Region.Equal(other.Region) && p.Dedicated.Equal(other.Dedicated) &&
+		p.Tags.Equal(other.Tags) && p.StoragePolicy.Equal(other.StoragePolicy)
+}
+
+// planPlacement plans the data plane chosen using placement, while creating or when placement changes.
+// It's left unknown to be chosen while applying, when placement depends on values not known yet.
+func (r *clusterResource) planPlacement(ctx context.Context, diags *diag.Diagnostics, plan *tfsdk.Plan, planModel *clusterResourceModel, state *clusterResourceModel) {
+	if r.client == nil || planModel.Placement == nil {
+		return
+	}
+	// existing clusters stay where they are, unless placement changes, imported ones have no placement to compare with
+	if !state.ID.IsNull() && (state.Placement == nil || state.Placement.equals(planModel.Placement)) {
+		return
+	}
+	if !r.isPlacementKnown(planModel) {
+		for _, attrName := range []string{"provider_type", "region", "data_plane_id", "storage_policy_name"} {
+			diags.Append(plan.SetAttribute(ctx, path.Root(attrName), types.StringUnknown())...)
+		}
+		return
+	}
+	if r.resolvePlacement(ctx, diags, planModel); diags.HasError() {
+		return
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("provider_type"), planModel.Provider)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("region"), planModel.Region)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("data_plane_id"), planModel.DataPlaneId)...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("storage_policy_name"), planModel.StoragePolicyName)...)
+}
+
+func (r *clusterResource) isPlacementKnown(plan *clusterResourceModel) bool {
+	placement := plan.Placement
+	return !plan.ServiceType.IsUnknown() && !plan.InstanceSize.IsUnknown() && !placement.Provider.IsUnknown() &&
+		!placement.Region.IsUnknown() && !placement.Dedicated.IsUnknown() && !placement.Tags.IsUnknown() && !placement.StoragePolicy.IsUnknown()
+}
+
+// resolvePlacement chooses the data plane matching the placement of plan, & sets the provider, region, data plane & storage policy of plan.
+func (r *clusterResource) resolvePlacement(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	placement := plan.Placement
+	placementPath := path.Root("placement")
+	var requiredTags []string
+	if diags.Append(placement.Tags.ElementsAs(ctx, &requiredTags, true)...); diags.HasError() {
+		return
+	}
+
+	instanceTypes, err := r.client.Controller.GetServiceInstanceTypes(&controller.InstanceTypesQuery{
+		ServiceType: plan.ServiceType.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Choosing data plane", "Could not fetch instance types, unexpected error: "+err.Error())
+		return
+	}
+	var instanceType *model.InstanceType
+	for i := range instanceTypes.InstanceTypes {
+		if instanceTypes.InstanceTypes[i].InstanceSize == plan.InstanceSize.ValueString() {
+			instanceType = &instanceTypes.InstanceTypes[i]
+		}
+	}
+	if instanceType == nil {
+		diags.AddAttributeError(path.Root("instance_size"), "Invalid input",
+			fmt.Sprintf("Instance Size %q is not available for Service %q", plan.InstanceSize.ValueString(), plan.ServiceType.ValueString()))
+		return
+	}
+
+	// regions having data planes with enough capacity for the instance size
+	regionsQuery := &infra_connector.DataPlaneRegionsQuery{
+		Provider:  placement.Provider.ValueString(),
+		CPU:       instanceType.CPU,
+		Memory:    instanceType.Memory,
+		Storage:   instanceType.Storage,
+		NodeCount: instanceType.Metadata.Nodes,
+	}
+	dataPlanesQuery := &infra_connector.EligibleDataPlanesQuery{
+		InfraResourceType: "SHARED",
+		Provider:          placement.Provider.ValueString(),
+	}
+	if placement.Dedicated.ValueBool() {
+		regionsQuery.OrgId = r.client.Root.OrgId
+		dataPlanesQuery.InfraResourceType = "DEDICATED"
+		dataPlanesQuery.OrgId = r.client.Root.OrgId
+	}
+	regions, err := r.client.InfraConnector.GetRegionsWithDataPlanes(regionsQuery)
+	if err != nil {
+		diags.AddError("Choosing data plane", "Could not fetch regions, unexpected error: "+err.Error())
+		return
+	}
+	regionOf := make(map[string]string)
+	for region, dataPlaneIds := range regions {
+		if !placement.Region.IsNull() && region != placement.Region.ValueString() {
+			continue
+		}
+		for _, id := range dataPlaneIds {
+			regionOf[id] = region
+		}
+	}
+
+	eligible, err := r.client.InfraConnector.GetEligibleDataPlanes(dataPlanesQuery)
+	if err != nil {
+		diags.AddError("Choosing data plane", "Could not fetch eligible data planes, unexpected error: "+err.Error())
+		return
+	}
+	var candidates []model.EligibleDataPlane
+	for _, dataPlane := range *eligible.Get() {
+		if _, found := regionOf[dataPlane.Id]; !found || len(dataPlane.StoragePolicies) == 0 {
+			continue
+		}
+		if len(requiredTags) > 0 {
+			details, err := r.client.InfraConnector.GetDataPlaneById(dataPlane.Id)
+			if err != nil {
+				diags.AddError("Choosing data plane", "Could not fetch data plane "+dataPlane.Id+", unexpected error: "+err.Error())
+				return
+			}
+			if !slices.ContainsFunc(requiredTags, func(tag string) bool { return !slices.Contains(details.Tags, tag) }) {
+				candidates = append(candidates, dataPlane)
+			}
+			continue
+		}
+		candidates = append(candidates, dataPlane)
+	}
+	if len(candidates) == 0 {
+		diags.AddAttributeError(placementPath, "No eligible data plane",
+			fmt.Sprintf("None of the data planes of provider %q matches the placement & has capacity for Instance Size %q.", placement.Provider.ValueString(), instanceType.InstanceSize))
+		return
+	}
+
+	preferred := placement.StoragePolicy.ValueString()
+	slices.SortFunc(candidates, func(a, b model.EligibleDataPlane) int {
+		aPreferred, bPreferred := slices.Contains(a.StoragePolicies, preferred), slices.Contains(b.StoragePolicies, preferred)
+		if aPreferred != bPreferred {
+			if aPreferred {
+				return -1
+			}
+			return 1
+		}
+		if a.DataPlaneName != b.DataPlaneName {
+			return strings.Compare(a.DataPlaneName, b.DataPlaneName)
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	chosen := candidates[0]
+	storagePolicy := preferred
+	if !slices.Contains(chosen.StoragePolicies, preferred) {
+		storagePolicies := slices.Clone(chosen.StoragePolicies)
+		slices.Sort(storagePolicies)
+		storagePolicy = storagePolicies[0]
+	}
+	tflog.Info(ctx, "chose data plane using placement", map[string]interface{}{
+		"data_plane_id":   chosen.Id,
+		"data_plane_name": chosen.DataPlaneName,
+		"region":          regionOf[chosen.Id],
+		"storage_policy":  storagePolicy,
+	})
+
+	plan.Provider = placement.Provider
+	plan.Region = types.StringValue(regionOf[chosen.Id])
+	plan.DataPlaneId = types.StringValue(chosen.Id)
+	plan.StoragePolicyName = types.StringValue(storagePolicy)
+}
+
+// planPasswordHash plans the hash of the password read from password_env, so that a change of variable's value shows up
+// as a change: the hash in state is kept while it matches the password, else a new one is computed while applying.
+// The configured password is in the state itself, so no hash is kept for it.
+func (r *clusterResource) planPasswordHash(ctx context.Context, diags *diag.Diagnostics, state *tfsdk.State, plan *tfsdk.Plan, planModel *clusterResourceModel) {
+	metadata := planModel.ClusterMetadata
+	if metadata == nil || metadata.Password.IsUnknown() || metadata.PasswordEnv.IsUnknown() {
+		return
+	}
+	hashPath := path.Root("cluster_metadata").AtName("password_hash")
+	if metadata.PasswordEnv.IsNull() {
+		diags.Append(plan.SetAttribute(ctx, hashPath, types.StringNull())...)
+		return
+	}
+	password, err := clusterPassword(ctx, metadata)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_metadata").AtName("password_env"), "Invalid input", err.Error())
+		return
+	}
+	hash := types.StringNull()
+	if !state.Raw.IsNull() {
+		if diags.Append(state.GetAttribute(ctx, hashPath, &hash)...); diags.HasError() {
+			return
+		}
+	}
+	if !hash.IsNull() && bcrypt.CompareHashAndPassword([]byte(hash.ValueString()), []byte(password)) == nil {
+		diags.Append(plan.SetAttribute(ctx, hashPath, hash)...)
+		return
+	}
+	diags.Append(plan.SetAttribute(ctx, hashPath, types.StringUnknown())...)
+}
+
+// setPasswordHash sets the hash of the password read from password_env in the plan, unless it's kept from the state.
+func (r *clusterResource) setPasswordHash(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	metadata := plan.ClusterMetadata
+	if metadata == nil || (!metadata.PasswordHash.IsNull() && !metadata.PasswordHash.IsUnknown()) {
+		return
+	}
+	metadata.PasswordHash = types.StringNull()
+	if metadata.PasswordEnv.IsNull() {
+		return
+	}
+	password, err := clusterPassword(ctx, metadata)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_metadata").AtName("password_env"), "Invalid input", err.Error())
+		return
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		diags.AddError("Hashing TDH Cluster password", "Could not hash the password, unexpected error: "+err.Error())
+		return
+	}
+	metadata.PasswordHash = types.StringValue(string(hash))
+}
+
+// passwordChanged returns true if the planned password differs from the one in state, or the one hashed in state.
+// Password isn't known for imported clusters, so there's nothing to compare with.
+func (r *clusterResource) passwordChanged(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, plan *clusterResourceModel) bool {
+	if state.ClusterMetadata == nil || plan.ClusterMetadata == nil {
+		return false
+	}
+	password, err := clusterPassword(ctx, plan.ClusterMetadata)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_metadata").AtName("password_env"), "Invalid input", err.Error())
+		return false
+	}
+	stateHash := state.ClusterMetadata.PasswordHash.ValueString()
+	switch {
+	case !state.ClusterMetadata.Password.IsNull():
+		return password != state.ClusterMetadata.Password.ValueString()
+	case stateHash == "":
+		return false
+	case !strings.HasPrefix(stateHash, "$2"):
+		// plain SHA-256 saved by earlier versions
+		legacyHash := sha256.Sum256([]byte(password))
+		return hex.EncodeToString(legacyHash[:]) != stateHash
+	}
+	return bcrypt.CompareHashAndPassword([]byte(stateHash), []byte(password)) != nil
+}
+
+// connectionChanged returns true if the password, the connection URI of cluster or the database to connect to changes.
+func (r *clusterResource) connectionChanged(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, plan *clusterResourceModel) bool {
+	connectionUri := func(m *clusterResourceModel) attr.Value {
+		if m.Metadata.IsNull() || m.Metadata.IsUnknown() {
+			return m.Metadata
+		}
+		return m.Metadata.Attributes()["connection_uri"]
+	}
+	if !connectionUri(plan).Equal(connectionUri(state)) {
+		return true
+	}
+	if plan.ClusterMetadata == nil || state.ClusterMetadata == nil {
+		return plan.ClusterMetadata != state.ClusterMetadata
+	}
+	if !plan.ClusterMetadata.Database.Equal(state.ClusterMetadata.Database) ||
+		plan.ClusterMetadata.Password.IsUnknown() || plan.ClusterMetadata.PasswordEnv.IsUnknown() {
+		return true
+	}
+	return r.passwordChanged(ctx, diags, state, plan)
+}
+
+// clusterPasswordValidators validate the password of cluster, whether configured or read from the environment variable.
+var clusterPasswordValidators = []validator.String{
+	stringvalidator.LengthBetween(8, 24),
+	validators.PasswordValidator{},
+}
+
+// clusterPassword returns the password of cluster, either configured or read from the environment variable.
+// Password read from the variable is validated same as the configured one.
+func clusterPassword(ctx context.Context, metadata *clusterMetadataModel) (string, error) {
+	if metadata.PasswordEnv.IsNull() {
+		return metadata.Password.ValueString(), nil
+	}
+	password := os.Getenv(metadata.PasswordEnv.ValueString())
+	if password == "" {
+		return "", fmt.Errorf("environment variable %q holding password for the cluster is not set", metadata.PasswordEnv.ValueString())
+	}
+	for _, passwordValidator := range clusterPasswordValidators {
+		validation := validator.StringResponse{}
+		passwordValidator.ValidateString(ctx, validator.StringRequest{
+			Path:        path.Root("cluster_metadata").AtName("password_env"),
+			ConfigValue: types.StringValue(password),
+		}, &validation)
+		if validation.Diagnostics.HasError() {
+			return "", fmt.Errorf("password held by environment variable %q is invalid: %s",
+				metadata.PasswordEnv.ValueString(), validation.Diagnostics.Errors()[0].Detail())
+		}
+	}
+	return password, nil
+}
+
+// immutableAttribute holds the state & plan values of an attribute that can only be set during creation.
+type immutableAttribute struct {
+	path  path.Path
+	state attr.Value
+	plan  attr.Value
+}
+
+// immutableAttributes returns the attributes of the cluster that can't be changed in-place.
+func (r *clusterResource) immutableAttributes(state *clusterResourceModel, plan *clusterResourceModel) []immutableAttribute {
+	attributes := []immutableAttribute{
+		{path.Root("name"), state.Name, plan.Name},
+		{path.Root("service_type"), state.ServiceType, plan.ServiceType},
+		{path.Root("provider_type"), state.Provider, plan.Provider},
+		{path.Root("data_plane_id"), state.DataPlaneId, plan.DataPlaneId},
+		{path.Root("storage_policy_name"), state.StoragePolicyName, plan.StoragePolicyName},
+		{path.Root("dedicated"), state.Dedicated, plan.Dedicated},
+		{path.Root("shared"), state.Shared, plan.Shared},
+		{path.Root("source_backup_id"), state.SourceBackupId, plan.SourceBackupId},
+		{path.Root("source_cluster_id"), state.SourceClusterId, plan.SourceClusterId},
+	}
+	if state.ClusterMetadata == nil {
+		return attributes
+	}
+	metadataPath := path.Root("cluster_metadata")
+	if plan.ClusterMetadata == nil {
+		return append(attributes, immutableAttribute{metadataPath, types.BoolValue(true), types.BoolValue(false)})
+	}
+	return append(attributes,
+		immutableAttribute{metadataPath.AtName("username"), state.ClusterMetadata.Username, plan.ClusterMetadata.Username},
+		immutableAttribute{metadataPath.AtName("database"), state.ClusterMetadata.Database, plan.ClusterMetadata.Database},
+		immutableAttribute{metadataPath.AtName("object_storage_id"), state.ClusterMetadata.ObjectStoreId, plan.ClusterMetadata.ObjectStoreId},
+	)
+}
+
+// Create a new resource
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan clusterResourceModel
+
+	tflog.Info(ctx, "INIT__Fetching plan")
+	diags := req.Plan.Get(ctx, &plan)
+	tflog.Info(ctx, "INIT__Fetched plan")
+
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Placement != nil && plan.DataPlaneId.IsUnknown() {
+		if r.resolvePlacement(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if r.validateInputs(&ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "INIT__Creating req body")
+
+	// Generate API request body from plan
+	clusterRequest := controller.ClusterCreateRequest{
+		Name:              plan.Name.ValueString(),
+		ServiceType:       plan.ServiceType.ValueString(),
+		InstanceSize:      plan.InstanceSize.ValueString(),
+		Provider:          plan.Provider.ValueString(),
+		Region:            plan.Region.ValueString(),
+		Dedicated:         plan.isDedicated(),
+		Shared:            plan.Shared.ValueBool(),
+		DataPlaneId:       plan.DataPlaneId.ValueString(),
+		Version:           plan.Version.ValueString(),
+		StoragePolicyName: plan.StoragePolicyName.ValueString(),
+	}
+	if plan.ClusterMetadata != nil {
+		password, err := clusterPassword(ctx, plan.ClusterMetadata)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cluster_metadata").AtName("password_env"), "Invalid input", err.Error())
+			return
+		}
+		clusterRequest.ClusterMetadata = controller.ClusterMetadata{
+			Username:      plan.ClusterMetadata.Username.ValueString(),
+			Password:      password,
+			Database:      plan.ClusterMetadata.Database.ValueString(),
+			ObjectStoreId: plan.ClusterMetadata.ObjectStoreId.ValueString(),
+		}
+		plan.ClusterMetadata.Extensions.ElementsAs(ctx, &clusterRequest.ClusterMetadata.Extensions, true)
+		if r.setPasswordHash(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	createApi := r.client.Controller.CreateCluster
+	if sourceBackup := r.resolveSourceBackup(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+		return
+	} else if sourceBackup != nil {
+		utils.SetRestoreFrom(&clusterRequest, sourceBackup.Id)
+		createApi = utils.RestoreApi(r.client, clusterRequest.ServiceType)
+		plan.SourceBackupId = types.StringValue(sourceBackup.Id)
+	} else if plan.SourceBackupId.IsUnknown() {
+		plan.SourceBackupId = types.StringNull()
+	}
+
+	tflog.Info(ctx, "INIT__Created req body")
+	loggedRequest := clusterRequest
+	if loggedRequest.ClusterMetadata.Password != "" {
+		loggedRequest.ClusterMetadata.Password = "********"
+	}
+	tflog.Info(ctx, "Creating cluster", map[string]interface{}{
+		"cluster_request": loggedRequest,
+	})
+
+	plan.Tags.ElementsAs(ctx, &clusterRequest.Tags, true)
+	plan.NetworkPolicyIds.ElementsAs(ctx, &clusterRequest.NetworkPolicyIds, true)
+	plan.Parameters.ElementsAs(ctx, &clusterRequest.Parameters, true)
+
+	tflog.Info(ctx, "INIT__Submitting request")
+
+	response, err := createApi(&clusterRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Submitting request to create cluster",
+			"Could not create cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	pendingTask := &utils.PendingTask{
+		TaskId:       response.TaskId,
+		ResourceName: clusterRequest.Name,
+		ServiceType:  clusterRequest.ServiceType,
+	}
+	if plan.AsyncCreate.ValueBool() {
+		tflog.Info(ctx, "not waiting for cluster creation as requested", map[string]interface{}{"task_id": response.TaskId})
+		r.savePendingCreation(ctx, resp, &plan, pendingTask)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		if utils.IsInterrupted(err) {
+			if r.savePendingCreation(ctx, resp, &plan, pendingTask); resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.AddWarning("Cluster creation still in progress",
+				fmt.Sprintf("Waiting for the task [ID: %s] was interrupted, the cluster %q may still get created. "+
+					"Next run will resume waiting on it and adopt the created cluster.", pendingTask.TaskId, pendingTask.ResourceName),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Error in creating cluster",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "INIT__Fetching clusters")
+	createdCluster := r.fetchCreatedCluster(ctx, &resp.Diagnostics, pendingTask)
+	if createdCluster == nil {
+		return
+	}
+	if plan.WaitForReady.ValueBool() {
+		if createdCluster = r.waitForClusterReady(ctx, &resp.Diagnostics, createdCluster); createdCluster == nil {
+			return
+		}
+	}
+
+	// maintenance settings can only be applied once cluster exists
+	if !plan.MaintenanceWindow.IsUnknown() || !plan.PauseUpdates.IsUnknown() {
+		updateRequest := r.buildUpdateRequest(ctx, &resp.Diagnostics, &plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if createdCluster, err = r.client.Controller.UpdateCluster(createdCluster.ID, updateRequest); err != nil {
+			resp.Diagnostics.AddError("Updating TDH Cluster",
+				"Could not apply maintenance settings to the created cluster, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	tflog.Info(ctx, "INIT__Saving Response")
+	if r.saveFromResponse(&ctx, &resp.Diagnostics, &plan, createdCluster) != 0 {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// extensions can't be sent along with the backup to restore, so they're enabled once the cluster is restored;
+	// if that fails, the extensions actually enabled are read on next refresh & enabling them is retried
+	if !plan.SourceBackupId.IsNull() && plan.ClusterMetadata != nil && len(plan.ClusterMetadata.Extensions.Elements()) > 0 {
+		if r.updateExtensions(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "END__Create")
+}
+
+// Read resource information
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state clusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if pendingTask, dgs := utils.GetPendingTask(ctx, req.Private); dgs.HasError() || pendingTask != nil {
+		if resp.Diagnostics.Append(dgs...); resp.Diagnostics.HasError() {
+			return
+		}
+		r.resumePendingCreation(ctx, resp, &state, pendingTask)
+		return
+	}
+
+	tflog.Debug(ctx, "INIT_Read Fetching Cluster from API")
+	// Get refreshed cluster value from TDH
+	cluster, err := r.client.Controller.GetCluster(state.ID.ValueString())
+	tflog.Debug(ctx, "INIT__Read fetched cluster", map[string]interface{}{"dto": cluster})
+	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "cluster not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Reading TDH Cluster",
+			"Could not read TDH cluster ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "INIT__Read converting response")
+	// Overwrite items with refreshed state
+	if r.saveFromResponse(&ctx, &resp.Diagnostics, &state, cluster) != 0 {
+		return
+	}
+	if r.readExtensions(ctx, &resp.Diagnostics, &state); resp.Diagnostics.HasError() {
+		return
+	}
+	if r.readParameters(ctx, &resp.Diagnostics, &state, cluster); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+
+	// Retrieve values from plan
+	var plan clusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve current state
+	var state clusterResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ID.ValueString() == "" {
+		resp.Diagnostics.AddError("Updating TDH Cluster",
+			fmt.Sprintf("Cluster %q is still being created, it can be changed once the creation completes.", state.Name.ValueString()),
+		)
+		return
+	}
+
+	// Detect version change
+	if plan.Version.ValueString() != state.Version.ValueString() {
 		tflog.Info(ctx, "Version change detected", map[string]interface{}{
 			"old_version": state.Version.ValueString(),
 			"new_version": plan.Version.ValueString(),
 		})
-		// Generate API request to update the version
-		versionUpdateRequest := upgrade_service.UpdateClusterVersionRequest{
-			Id:            state.ID.ValueString(),
-			TargetVersion: plan.Version.ValueString(),
-			RequestType:   "SERVICE",
+		if r.upgradeCluster(ctx, &resp.Diagnostics, &state, &plan); resp.Diagnostics.HasError() {
+			// keep the state in line with the version cluster is actually running
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// Detect instance size change
+	if plan.InstanceSize.ValueString() != state.InstanceSize.ValueString() {
+		tflog.Info(ctx, "Instance size change detected", map[string]interface{}{
+			"old_size": state.InstanceSize.ValueString(),
+			"new_size": plan.InstanceSize.ValueString(),
+		})
+		if r.resizeCluster(ctx, &resp.Diagnostics, &state, plan.InstanceSize.ValueString()); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Detect password change
+	if changed := r.passwordChanged(ctx, &resp.Diagnostics, &state, &plan); resp.Diagnostics.HasError() {
+		return
+	} else if changed {
+		tflog.Info(ctx, "Password change detected")
+		if r.rotatePassword(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if r.setPasswordHash(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Detect extensions change, nothing to apply when they are not managed; null & empty both mean none enabled
+	if state.ClusterMetadata != nil && plan.ClusterMetadata != nil &&
+		!plan.ClusterMetadata.Extensions.IsNull() && !plan.ClusterMetadata.Extensions.IsUnknown() &&
+		!plan.ClusterMetadata.Extensions.Equal(state.ClusterMetadata.Extensions) &&
+		(len(plan.ClusterMetadata.Extensions.Elements()) > 0 || len(state.ClusterMetadata.Extensions.Elements()) > 0) {
+		tflog.Info(ctx, "Extensions change detected", map[string]interface{}{
+			"old_extensions": state.ClusterMetadata.Extensions.String(),
+			"new_extensions": plan.ClusterMetadata.Extensions.String(),
+		})
+		if r.updateExtensions(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Detect parameters change, nothing to apply when they are no longer managed
+	if !plan.Parameters.IsNull() && !plan.Parameters.IsUnknown() && !plan.Parameters.Equal(state.Parameters) {
+		tflog.Info(ctx, "Parameters change detected", map[string]interface{}{
+			"old_parameters": state.Parameters.String(),
+			"new_parameters": plan.Parameters.String(),
+		})
+		if r.updateParameters(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Generate API request body from plan
+	updateRequest := r.buildUpdateRequest(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing cluster
+	cluster, err := r.client.Controller.UpdateCluster(plan.ID.ValueString(), updateRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Updating TDH Cluster",
+			"Could not update cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with updated items and timestamp
+	plannedConnection := plan.Connection
+	if r.saveFromResponse(&ctx, &resp.Diagnostics, &plan, cluster) != 0 {
+		return
+	}
+	// connection kept from state is refreshed by next read, cluster may not report it while being updated
+	if !plannedConnection.IsUnknown() {
+		plan.Connection = plannedConnection
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// values unknown since import have been adopted from config, changing them forces replacement from now on
+	resp.Diagnostics.Append(utils.ClearImported(ctx, resp.Private)...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state clusterResourceModel
+	diags := request.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deleting TDH Cluster",
+			fmt.Sprintf("Cluster %q has deletion protection enabled, set \"deletion_protection\" to false & apply before deleting it.", state.Name.ValueString()),
+		)
+		return
+	}
+	if state.ID.ValueString() == "" {
+		if adopted := r.awaitPendingCreation(ctx, &resp.Diagnostics, request.Private, &state); !adopted {
+			return
+		}
+	}
+	if state.FinalBackup.ValueBool() {
+		backupId := r.backupCluster(ctx, &resp.Diagnostics, &state, "Backing up TDH Cluster before deletion", "final",
+			fmt.Sprintf("Taken before deleting cluster %s", state.Name.ValueString()))
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.AddWarning("Final backup of TDH Cluster taken",
+			fmt.Sprintf("Backup %q of cluster %q was taken before deleting it, it can be used to restore the cluster.", backupId, state.Name.ValueString()),
+		)
+	}
+
+	// Submit request to delete TDH Cluster
+	response, err := r.client.Controller.DeleteCluster(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting TDH Cluster",
+			"Could not delete TDH cluster by ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		resp.Diagnostics.AddError("Deleting TDH Cluster",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+// ImportState imports the cluster by ID or by `service_type/name`, along with the attributes only used during creation.
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "INIT__ImportState")
+	var cluster *model.Cluster
+	if serviceType, name, found := strings.Cut(req.ID, "/"); found {
+		cluster = r.findClusterByName(&resp.Diagnostics, serviceType, name)
+	} else {
+		var err error
+		if cluster, err = r.client.Controller.GetCluster(req.ID); err != nil {
+			resp.Diagnostics.AddError("Importing TDH Cluster",
+				"Could not read TDH cluster ID "+req.ID+": "+err.Error(),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cluster.ID)...)
+
+	policies, err := r.client.CustomerMetadata.GetAllPolicies(&customer_metadata.PoliciesQuery{
+		Type:       policy_type.NETWORK,
+		ResourceId: cluster.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Importing TDH Cluster",
+			"Could not fetch network policies of the cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	policyIds := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		policyIds = append(policyIds, policy.ID)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_policy_ids"), policyIds)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("final_backup"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_ready"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("async_create"), false)...)
+	resp.Diagnostics.Append(utils.MarkImported(ctx, resp.Private)...)
+
+	// clusters of services not requiring credentials, like RabbitMQ, are created without any metadata
+	if serviceType := utils.GetServiceType(ctx, r.client, cluster.ServiceType); serviceType != nil && !serviceType.CredentialsRequired {
+		tflog.Info(ctx, "END__ImportState")
+		return
+	}
+	clusterMetadata := r.importClusterMetadata(ctx, &resp.Diagnostics, cluster)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_metadata"), clusterMetadata)...)
+
+	tflog.Info(ctx, "END__ImportState")
+}
+
+// findClusterByName returns the only cluster of the service type having exactly the given name.
+func (r *clusterResource) findClusterByName(diagnostics *diag.Diagnostics, serviceType string, name string) *model.Cluster {
+	clusters, err := r.client.Controller.GetAllClusters(&controller.ClustersQuery{
+		ServiceType:   serviceType,
+		Name:          name,
+		FullNameMatch: true,
+	})
+	if err != nil {
+		diagnostics.AddError("Importing TDH Cluster",
+			"Could not fetch clusters by name, unexpected error: "+err.Error(),
+		)
+		return nil
+	}
+	var matched []model.Cluster
+	for _, cluster := range clusters {
+		if cluster.Name == name && cluster.ServiceType == serviceType {
+			matched = append(matched, cluster)
+		}
+	}
+	if len(matched) != 1 {
+		diagnostics.AddError("Importing TDH Cluster",
+			fmt.Sprintf("Expected exactly one %s cluster named %q, found %d. Please import it by ID instead.", serviceType, name, len(matched)),
+		)
+		return nil
+	}
+	return &matched[0]
+}
+
+// importClusterMetadata rebuilds the cluster_metadata from what the cluster reports, credentials can't be read back, so they're left null.
+func (r *clusterResource) importClusterMetadata(ctx context.Context, diagnostics *diag.Diagnostics, cluster *model.Cluster) *clusterMetadataModel {
+	metaData, err := r.client.Controller.GetClusterMetaData(cluster.ID)
+	if err != nil {
+		diagnostics.AddError("Importing TDH Cluster",
+			"Could not fetch metadata of the cluster, unexpected error: "+err.Error(),
+		)
+		return nil
+	}
+	clusterMetadata := &clusterMetadataModel{
+		Username:      types.StringNull(),
+		Password:      types.StringNull(),
+		PasswordEnv:   types.StringNull(),
+		PasswordHash:  types.StringNull(),
+		Database:      types.StringNull(),
+		Extensions:    types.SetNull(types.StringType),
+		ObjectStoreId: types.StringNull(),
+	}
+	if cluster.Metadata != nil && cluster.Metadata.ObjectStoreId != "" {
+		clusterMetadata.ObjectStoreId = types.StringValue(cluster.Metadata.ObjectStoreId)
+	}
+
+	var databases []string
+	for _, database := range metaData.Databases {
+		if !slices.Contains(systemDatabases, database.Name) {
+			databases = append(databases, database.Name)
+		}
+	}
+	if len(databases) == 1 {
+		clusterMetadata.Database = types.StringValue(databases[0])
+	} else {
+		tflog.Warn(ctx, "could not determine the database created with the cluster", map[string]interface{}{"databases": databases})
+	}
+
+	// extensions are left null, i.e. not managed, until they're configured
+	return clusterMetadata
+}
+
+// resizeCluster validates the target size for the service of the cluster, then scales the cluster to it & waits for it.
+func (r *clusterResource) resizeCluster(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, targetSize string) {
+	instanceTypes, err := r.client.Controller.GetServiceInstanceTypes(&controller.InstanceTypesQuery{
+		ServiceType: state.ServiceType.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Resizing TDH Cluster",
+			"Could not fetch instance types, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var targetType *model.InstanceType
+	allowedSizes := make([]string, 0, len(instanceTypes.InstanceTypes))
+	for i, instanceType := range instanceTypes.InstanceTypes {
+		allowedSizes = append(allowedSizes, instanceType.InstanceSize)
+		if instanceType.InstanceSize == targetSize {
+			targetType = &instanceTypes.InstanceTypes[i]
+		}
+	}
+	if targetType == nil {
+		diags.AddAttributeError(path.Root("instance_size"), "Invalid input",
+			fmt.Sprintf("Instance Size %q is not available for Service %q, allowed values: %q", targetSize, state.ServiceType.ValueString(), allowedSizes))
+		return
+	}
+
+	cluster, err := r.client.Controller.GetCluster(state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Resizing TDH Cluster",
+			"Could not read TDH cluster ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if r.validateStorageForSize(ctx, diags, cluster, targetType); diags.HasError() {
+		return
+	}
+
+	response, err := r.client.Controller.ScaleCluster(state.ID.ValueString(), &controller.ClusterScaleRequest{
+		InstanceSize: targetSize,
+	})
+	if err != nil {
+		diags.AddError("Resizing TDH Cluster",
+			"Could not submit request to resize cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		diags.AddError("Resizing TDH Cluster",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// upgradeCluster upgrades the cluster to the planned version, after taking a fresh backup unless it's omitted,
+// and refreshes the state with the version cluster ends up running.
+func (r *clusterResource) upgradeCluster(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, plan *clusterResourceModel) {
+	previousVersion := state.Version.ValueString()
+	targetVersion := plan.Version.ValueString()
+
+	// the window may have ended since planning
+	if r.validateMaintenanceWindow(ctx, diags, plan); diags.HasError() {
+		return
+	}
+
+	omitBackup := plan.Upgrade != nil && plan.Upgrade.OmitBackup.ValueBool()
+	var backupId string
+	if !omitBackup {
+		backupDescription := fmt.Sprintf("Taken before upgrading from version %s", previousVersion)
+		if backupId = r.backupCluster(ctx, diags, state, "Backing up TDH Cluster before upgrade", "pre-upgrade", backupDescription); diags.HasError() {
+			return
 		}
+	}
+
+	// the fresh backup taken above replaces the one taken as part of upgrade
+	response, err := r.client.UpgradeService.UpdateClusterVersion(&upgrade_service.UpdateClusterVersionRequest{
+		Id:            state.ID.ValueString(),
+		TargetVersion: targetVersion,
+		RequestType:   "SERVICE",
+		Metadata: upgrade_service.UpdateClusterVersionRequestMetadata{
+			OmitBackup: "true",
+		},
+	})
+	if err != nil {
+		diags.AddError("Upgrading TDH Cluster",
+			"Could not submit request to upgrade cluster version, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	taskErr := utils.WaitForTask(ctx, r.client, response.TaskId)
+
+	cluster, err := r.waitForUpgradeCompletion(ctx, state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Upgrading TDH Cluster",
+			fmt.Sprintf("Could not verify upgrade of cluster from version %q to %q, unexpected error: %s", previousVersion, targetVersion, err.Error()),
+		)
+		return
+	}
+	if r.saveFromResponse(&ctx, diags, state, cluster) != 0 {
+		return
+	}
+	tflog.Info(ctx, "cluster upgrade finished", map[string]interface{}{
+		"previous_version": previousVersion,
+		"target_version":   targetVersion,
+		"current_version":  cluster.Version,
+	})
 
-		if plan.Upgrade != nil {
-			versionUpdateRequest.Metadata.OmitBackup = strconv.FormatBool(plan.Upgrade.OmitBackup.ValueBool())
+	if taskErr != nil || cluster.Version != targetVersion {
+		detail := fmt.Sprintf("Upgrade of cluster from version %q to %q did not succeed, cluster is running version %q.", previousVersion, targetVersion, cluster.Version)
+		if taskErr != nil {
+			detail += "\nTask error: " + taskErr.Error()
+		}
+		if backupId != "" {
+			detail += fmt.Sprintf("\nBackup %q taken before the upgrade can be used to restore the cluster.", backupId)
 		}
+		diags.AddError("Upgrading TDH Cluster", detail)
+	}
+}
 
-		// Call the API to update the version
-		response, err := r.client.UpgradeService.UpdateClusterVersion(&versionUpdateRequest)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Updating Cluster Version",
-				"Could not update cluster version, unexpected error: "+err.Error(),
+// backupCluster takes an on-demand backup of the cluster named after the given prefix & waits for it to succeed, returning its ID.
+func (r *clusterResource) backupCluster(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, title string, namePrefix string, description string) string {
+	backupName := fmt.Sprintf("%s-%s", namePrefix, time.Now().UTC().Format("20060102150405"))
+	response, err := r.client.Controller.CreateClusterBackup(state.ID.ValueString(), &controller.BackupCreateRequest{
+		Name:           backupName,
+		Description:    description,
+		BackupSchedule: "ON_DEMAND",
+		BackupType:     "FULL",
+	})
+	if err != nil {
+		diags.AddError(title,
+			"Could not submit request to backup cluster, unexpected error: "+err.Error(),
+		)
+		return ""
+	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		diags.AddError(title,
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return ""
+	}
+
+	backups, err := r.client.Controller.GetClusterBackups(&controller.BackupsQuery{
+		Name:      backupName,
+		ClusterId: state.ID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError(title,
+			"Could not fetch cluster backups by name, unexpected error: "+err.Error(),
+		)
+		return ""
+	}
+	if len(*backups.Get()) == 0 {
+		diags.AddError(title,
+			fmt.Sprintf("Could not find the backup %q just taken.", backupName),
+		)
+		return ""
+	}
+	backup := (*backups.Get())[0]
+
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+	for !slices.Contains(successfulBackupStatuses, backup.Status) {
+		if slices.Contains(failedBackupStatuses, backup.Status) {
+			diags.AddError(title,
+				fmt.Sprintf("Backup %q ended with status %q.", backup.Id, backup.Status),
 			)
-			return
+			return ""
 		}
-		if err = utils.WaitForTask(r.client, response.TaskId); err != nil {
-			resp.Diagnostics.AddError("Updating Cluster Version",
-				"Operation error: "+err.Error(),
+		select {
+		case <-ctx.Done():
+			diags.AddError(title,
+				fmt.Sprintf("Stopped waiting for backup %q to complete: %s", backup.Id, ctx.Err()),
 			)
-			return
+			return ""
+		case <-ticker.C:
 		}
-		resp.State.RemoveResource(ctx)
+		latest, err := r.client.Controller.GetBackup(backup.Id)
+		if err != nil {
+			diags.AddError(title,
+				"Could not check progress of backup, unexpected error: "+err.Error(),
+			)
+			return ""
+		}
+		backup = *latest
 	}
+	tflog.Info(ctx, "backup of cluster taken", map[string]interface{}{"backup_id": backup.Id, "name": backupName})
+	return backup.Id
+}
 
-	// Generate API request body from plan
-	var updateRequest controller.ClusterUpdateRequest
-	plan.Tags.ElementsAs(ctx, &updateRequest.Tags, true)
+// validateMaintenanceWindow refuses upgrading the cluster outside its maintenance window, telling when it can be done.
+func (r *clusterResource) validateMaintenanceWindow(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	window := r.maintenanceWindowOf(ctx, diags, plan)
+	if window == nil {
+		return
+	}
+	start, end := window.Next(time.Now())
+	if time.Now().Before(start) {
+		diags.AddAttributeError(path.Root("version"), "Upgrading TDH Cluster",
+			fmt.Sprintf("Version of cluster can only be changed within its maintenance window, the next one starts at %s & ends at %s. "+
+				"Please apply the change once it has started.", start.Format(time.RFC3339), end.Format(time.RFC3339)),
+		)
+	}
+}
 
-	// Update existing cluster
-	cluster, err := r.client.Controller.UpdateCluster(plan.ID.ValueString(), &updateRequest)
+// waitForUpgradeCompletion waits until cluster no longer reports an upgrade in progress & returns it.
+func (r *clusterResource) waitForUpgradeCompletion(ctx context.Context, id string) (*model.Cluster, error) {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+	for {
+		cluster, err := r.client.Controller.GetCluster(id)
+		if err != nil {
+			return nil, err
+		}
+		if !cluster.UpgradeInProgress {
+			return cluster, nil
+		}
+		tflog.Debug(ctx, "upgrade of cluster still in progress", map[string]interface{}{"id": id})
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("stopped waiting for upgrade to complete: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// validateTargetVersion refuses a version that the cluster can't be upgraded to.
+func (r *clusterResource) validateTargetVersion(diags *diag.Diagnostics, clusterId string, version string) {
+	targetVersions, err := r.client.UpgradeService.GetClusterTargetVersions(clusterId)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Updating TDH Cluster",
-			"Could not update cluster, unexpected error: "+err.Error(),
+		diags.AddError("Validating TDH Cluster version",
+			"Could not fetch target versions of the cluster, unexpected error: "+err.Error(),
 		)
 		return
 	}
+	if !slices.Contains(targetVersions.TargetVersions, version) {
+		diags.AddAttributeError(path.Root("version"), "Invalid input",
+			fmt.Sprintf("Cluster running version %q can't be upgraded to %q, allowed values: %q", targetVersions.Version, version, targetVersions.TargetVersions))
+	}
+}
+
+// updateExtensions enables & disables the extensions of cluster to match the plan, after validating them against
+// the extensions available for version of the cluster.
+func (r *clusterResource) updateExtensions(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	var extensions []string
+	if diags.Append(plan.ClusterMetadata.Extensions.ElementsAs(ctx, &extensions, true)...); diags.HasError() {
+		return
+	}
+	if len(extensions) > 0 {
+		available, err := r.client.Controller.GetServiceExtensions(&controller.ServiceExtensionsQuery{
+			ServiceType: plan.ServiceType.ValueString(),
+			Version:     plan.Version.ValueString(),
+		})
+		if err != nil {
+			diags.AddError("Updating TDH Cluster extensions",
+				"Could not fetch available extensions, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		availableNames := make([]string, 0, len(*available.Get()))
+		for _, extension := range *available.Get() {
+			availableNames = append(availableNames, extension.Name)
+		}
+		for _, extension := range extensions {
+			if !slices.Contains(availableNames, extension) {
+				diags.AddAttributeError(path.Root("cluster_metadata").AtName("extensions"), "Invalid input",
+					fmt.Sprintf("Extension %q is not available for Service %q version %q, allowed values: %q",
+						extension, plan.ServiceType.ValueString(), plan.Version.ValueString(), availableNames))
+			}
+		}
+		if diags.HasError() {
+			return
+		}
+	}
 
-	// Update resource state with updated items and timestamp
-	if r.saveFromResponse(&ctx, &resp.Diagnostics, &plan, cluster) != 0 {
+	response, err := r.client.Controller.UpdateClusterExtensions(plan.ID.ValueString(), &controller.ClusterExtensionsUpdateRequest{
+		Extensions: extensions,
+	})
+	if err != nil {
+		diags.AddError("Updating TDH Cluster extensions",
+			"Could not submit request to update extensions, unexpected error: "+err.Error(),
+		)
 		return
 	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		diags.AddError("Updating TDH Cluster extensions",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+	}
+}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// rotatePassword changes the password of the cluster admin user to the planned one.
+func (r *clusterResource) rotatePassword(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	password, err := clusterPassword(ctx, plan.ClusterMetadata)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_metadata").AtName("password_env"), "Invalid input", err.Error())
+		return
+	}
+	response, err := r.client.Controller.UpdateClusterCredentials(plan.ID.ValueString(), &controller.ClusterCredentialsUpdateRequest{
+		Username: plan.ClusterMetadata.Username.ValueString(),
+		Password: password,
+	})
+	if err != nil {
+		diags.AddError("Rotating TDH Cluster password",
+			"Could not submit request to rotate password, unexpected error: "+err.Error(),
+		)
 		return
 	}
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		diags.AddError("Rotating TDH Cluster password",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+	}
+}
 
-	tflog.Info(ctx, "END__Update")
+// readExtensions refreshes the extensions of a cluster supporting them from the ones reported enabled on it.
+// Extensions are tracked only when managed, i.e. set in the config & so in the state.
+func (r *clusterResource) readExtensions(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel) {
+	if state.ClusterMetadata == nil || state.ClusterMetadata.Extensions.IsNull() {
+		return
+	}
+	if serviceType := utils.GetServiceType(ctx, r.client, state.ServiceType.ValueString()); serviceType == nil || !serviceType.ExtensionsSupported {
+		return
+	}
+	metaData, err := r.client.Controller.GetClusterMetaData(state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Reading TDH Cluster extensions",
+			"Could not fetch metadata of the cluster, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// nothing enabled, keep it as configured, either null or empty
+	if len(metaData.PostgresExtensionData) == 0 && len(state.ClusterMetadata.Extensions.Elements()) == 0 {
+		return
+	}
+	extensions := make([]string, 0, len(metaData.PostgresExtensionData))
+	for _, extension := range metaData.PostgresExtensionData {
+		extensions = append(extensions, extension.Name)
+	}
+	var dgs diag.Diagnostics
+	state.ClusterMetadata.Extensions, dgs = types.SetValueFrom(ctx, types.StringType, extensions)
+	diags.Append(dgs...)
 }
 
-func (r *clusterResource) Delete(ctx context.Context, request resource.DeleteRequest, resp *resource.DeleteResponse) {
-	tflog.Info(ctx, "INIT__Delete")
-	// Get current state
-	var state clusterResourceModel
-	diags := request.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// validateParameters refuses the parameters not allowed for the service type of the cluster.
+func (r *clusterResource) validateParameters(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	if plan.ServiceType.IsUnknown() || plan.Parameters.IsNull() || plan.Parameters.IsUnknown() {
+		return
+	}
+	// service type not enabled on TDH is reported already
+	serviceType := utils.GetServiceType(ctx, r.client, plan.ServiceType.ValueString())
+	if serviceType == nil {
 		return
 	}
+	allowed := serviceType.Parameters
+	for name := range plan.Parameters.Elements() {
+		if !slices.Contains(allowed, name) {
+			diags.AddAttributeError(path.Root("parameters").AtMapKey(name), "Invalid input",
+				fmt.Sprintf("Parameter %q is not supported for Service %q, allowed values: %q", name, plan.ServiceType.ValueString(), allowed))
+		}
+	}
+	tflog.Debug(ctx, "validated parameters", map[string]interface{}{"parameters": plan.Parameters.String()})
+}
 
-	// Submit request to delete TDH Cluster
-	response, err := r.client.Controller.DeleteCluster(state.ID.ValueString())
+// updateParameters applies the planned configuration parameters to the cluster.
+func (r *clusterResource) updateParameters(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) {
+	var parameters map[string]string
+	if diags.Append(plan.Parameters.ElementsAs(ctx, &parameters, false)...); diags.HasError() {
+		return
+	}
+	response, err := r.client.Controller.UpdateClusterParameters(plan.ID.ValueString(), &controller.ClusterParametersUpdateRequest{
+		Parameters: parameters,
+	})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Deleting TDH Cluster",
-			"Could not delete TDH cluster by ID "+state.ID.ValueString()+": "+err.Error(),
+		diags.AddError("Updating TDH Cluster parameters",
+			"Could not submit request to update parameters, unexpected error: "+err.Error(),
 		)
 		return
 	}
-	if err = utils.WaitForTask(r.client, response.TaskId); err != nil {
-		resp.Diagnostics.AddError("Deleting TDH Cluster",
+	if err = utils.WaitForTask(ctx, r.client, response.TaskId); err != nil {
+		diags.AddError("Updating TDH Cluster parameters",
 			"Task responsible for this operation failed, error: "+err.Error(),
 		)
+	}
+}
+
+// readParameters refreshes the managed parameters from the ones reported by the cluster, dropping the ones it no longer has.
+func (r *clusterResource) readParameters(ctx context.Context, diags *diag.Diagnostics, state *clusterResourceModel, cluster *model.Cluster) {
+	// not managed or not reported, nothing to compare against
+	if state.Parameters.IsNull() || cluster.Parameters == nil {
 		return
 	}
+	parameters := make(map[string]string, len(state.Parameters.Elements()))
+	for name := range state.Parameters.Elements() {
+		if value, ok := cluster.Parameters[name]; ok {
+			parameters[name] = value
+		}
+	}
+	var dgs diag.Diagnostics
+	state.Parameters, dgs = types.MapValueFrom(ctx, types.StringType, parameters)
+	diags.Append(dgs...)
+}
 
-	tflog.Info(ctx, "END__Delete")
+// validateStorageForSize refuses a size whose storage can't hold the data currently stored by the cluster.
+func (r *clusterResource) validateStorageForSize(ctx context.Context, diags *diag.Diagnostics, cluster *model.Cluster, targetType *model.InstanceType) {
+	if cluster.StorageUsed == "" {
+		tflog.Warn(ctx, "storage usage of cluster is not known, skipping its validation")
+		return
+	}
+	used, err := utils.ParseStorageSize(cluster.StorageUsed)
+	if err != nil {
+		tflog.Warn(ctx, "could not parse storage usage of cluster, skipping its validation", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	available, err := utils.ParseStorageSize(targetType.Storage)
+	if err != nil {
+		tflog.Warn(ctx, "could not parse storage of instance size, skipping its validation", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	if used > available {
+		diags.AddAttributeError(path.Root("instance_size"), "Invalid input",
+			fmt.Sprintf("Instance Size %q provides storage of %s, which is less than %s currently used by the cluster", targetType.InstanceSize, targetType.Storage, cluster.StorageUsed))
+	}
 }
 
-func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// waitForClusterReady polls the cluster until its status is READY with connection URI available, returning the latest one.
+func (r *clusterResource) waitForClusterReady(ctx context.Context, diags *diag.Diagnostics, cluster *model.Cluster) *model.Cluster {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+	for cluster.Status != cluster_status.READY || cluster.Metadata == nil || cluster.Metadata.ConnectionUri == "" {
+		if cluster.Status == cluster_status.FAILED {
+			diags.AddError("Waiting for TDH Cluster to be ready",
+				fmt.Sprintf("Cluster %q [ID: %s] has been created with status %q.", cluster.Name, cluster.ID, cluster.Status),
+			)
+			return nil
+		}
+		tflog.Info(ctx, "waiting for cluster to be ready", map[string]interface{}{"id": cluster.ID, "status": cluster.Status})
+		select {
+		case <-ctx.Done():
+			diags.AddError("Waiting for TDH Cluster to be ready",
+				fmt.Sprintf("Stopped waiting for cluster %q [ID: %s] to be ready, last status %q: %s", cluster.Name, cluster.ID, cluster.Status, ctx.Err()),
+			)
+			return nil
+		case <-ticker.C:
+		}
+		latest, err := r.client.Controller.GetCluster(cluster.ID)
+		if err != nil {
+			diags.AddError("Waiting for TDH Cluster to be ready",
+				"Could not check status of the cluster, unexpected error: "+err.Error(),
+			)
+			return nil
+		}
+		cluster = latest
+	}
+	return cluster
+}
+
+// savePendingCreation saves what is known about the cluster being created, along with the task creating it,
+// so that next run resumes waiting on it instead of creating it again.
+func (r *clusterResource) savePendingCreation(ctx context.Context, resp *resource.CreateResponse, plan *clusterResourceModel, pendingTask *utils.PendingTask) {
+	tflog.Info(ctx, "saving pending task of cluster creation", map[string]interface{}{"task_id": pendingTask.TaskId})
+	if resp.Diagnostics.Append(utils.SavePendingTask(ctx, resp.Private, pendingTask)...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringNull()
+	plan.OrgId = types.StringNull()
+	plan.Status = types.StringNull()
+	plan.LastUpdated = types.StringNull()
+	plan.Created = types.StringNull()
+	plan.Metadata = types.ObjectNull(plan.Metadata.AttributeTypes(ctx))
+	if plan.MaintenanceWindow.IsUnknown() {
+		plan.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttrTypes)
+	}
+	if plan.PauseUpdates.IsUnknown() {
+		plan.PauseUpdates = types.BoolNull()
+	}
+	plan.Connection = types.ObjectNull(connectionAttrTypes)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// resumePendingCreation waits on the creation task saved by an interrupted run, and adopts the created cluster.
+// The resource is removed from state if the task has failed, so that it gets created again.
+func (r *clusterResource) resumePendingCreation(ctx context.Context, resp *resource.ReadResponse, state *clusterResourceModel, pendingTask *utils.PendingTask) {
+	tflog.Info(ctx, "resuming wait on pending cluster creation", map[string]interface{}{"task_id": pendingTask.TaskId})
+	if state.AsyncCreate.ValueBool() {
+		// only adopt the cluster once created, without blocking the run
+		taskDto, err := r.client.TaskService.GetTask(pendingTask.TaskId)
+		if err != nil {
+			resp.Diagnostics.AddError("Reading TDH Cluster",
+				"Could not check progress of the cluster creation, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if !task_status.IsTerminal(taskDto.Status) {
+			tflog.Info(ctx, "cluster creation still in progress", map[string]interface{}{"task_id": pendingTask.TaskId, "status": taskDto.Status})
+			return
+		}
+	}
+	if err := utils.WaitForTask(ctx, r.client, pendingTask.TaskId); err != nil {
+		var taskErr *utils.TaskFailedError
+		if errors.As(err, &taskErr) {
+			resp.Diagnostics.AddWarning("Cluster creation failed",
+				fmt.Sprintf("Creation of cluster %q that was pending since an interrupted run has failed, it will be created again. Error: %s",
+					pendingTask.ResourceName, err.Error()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Reading TDH Cluster",
+			"Could not resume waiting on the cluster creation, error: "+err.Error(),
+		)
+		return
+	}
+	cluster := r.fetchCreatedCluster(ctx, &resp.Diagnostics, pendingTask)
+	if cluster == nil {
+		return
+	}
+	if r.saveFromResponse(&ctx, &resp.Diagnostics, state, cluster) != 0 {
+		return
+	}
+	if resp.Diagnostics.Append(resp.State.Set(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(utils.ClearPendingTask(ctx, resp.Private)...)
+}
+
+// awaitPendingCreation waits on the creation of cluster still pending, so that the created cluster can be deleted.
+// Returns true once the ID of created cluster is set in the state, false if there's no cluster to delete or on error.
+func (r *clusterResource) awaitPendingCreation(ctx context.Context, diags *diag.Diagnostics, private utils.PrivateState, state *clusterResourceModel) bool {
+	pendingTask, dgs := utils.GetPendingTask(ctx, private)
+	if diags.Append(dgs...); diags.HasError() {
+		return false
+	}
+	if pendingTask == nil {
+		diags.AddError("Deleting TDH Cluster",
+			fmt.Sprintf("ID of cluster %q isn't known & no creation of it is pending, please import the cluster to delete it.", state.Name.ValueString()),
+		)
+		return false
+	}
+	tflog.Info(ctx, "waiting on pending cluster creation before deleting it", map[string]interface{}{"task_id": pendingTask.TaskId})
+	if err := utils.WaitForTask(ctx, r.client, pendingTask.TaskId); err != nil {
+		var taskErr *utils.TaskFailedError
+		if errors.As(err, &taskErr) {
+			tflog.Warn(ctx, "cluster creation has failed, nothing to delete", map[string]interface{}{"task_id": pendingTask.TaskId, "error": err.Error()})
+			return false
+		}
+		diags.AddError("Deleting TDH Cluster",
+			"Could not wait on the pending cluster creation, error: "+err.Error(),
+		)
+		return false
+	}
+	cluster := r.fetchCreatedCluster(ctx, diags, pendingTask)
+	if cluster == nil {
+		return false
+	}
+	state.ID = types.StringValue(cluster.ID)
+	return true
+}
+
+// fetchCreatedCluster returns the cluster created by the given task, nil if it couldn't be found.
+// The cluster is looked up by the ID reported by the task, falling back to its exact name & service type.
+func (r *clusterResource) fetchCreatedCluster(ctx context.Context, diagnostics *diag.Diagnostics, pendingTask *utils.PendingTask) *model.Cluster {
+	return utils.FetchCreated(ctx, diagnostics, r.client, pendingTask, utils.CreatedLookup[model.Cluster]{
+		Kind:    "cluster",
+		GetById: r.client.Controller.GetCluster,
+		ListByName: func(pendingTask *utils.PendingTask) ([]model.Cluster, error) {
+			clusters, err := r.client.Controller.GetAllClusters(&controller.ClustersQuery{
+				ServiceType:   pendingTask.ServiceType,
+				Name:          pendingTask.ResourceName,
+				FullNameMatch: true,
+			})
+			var matches []model.Cluster
+			for _, cluster := range clusters {
+				if cluster.Name == pendingTask.ResourceName && cluster.ServiceType == pendingTask.ServiceType {
+					matches = append(matches, cluster)
+				}
+			}
+			return matches, err
+		},
+		IdOf: func(cluster *model.Cluster) string {
+			return cluster.ID
+		},
+	})
 }
 
 func (r *clusterResource) saveFromResponse(ctx *context.Context, diagnostics *diag.Diagnostics, state *clusterResourceModel, cluster *model.Cluster) int8 {
@@ -654,17 +2349,120 @@ func (r *clusterResource) saveFromResponse(ctx *context.Context, diagnostics *di
 		return 1
 	}
 	state.Tags = list
+
+	if r.saveConnection(*ctx, diagnostics, state, cluster); diagnostics.HasError() {
+		return 1
+	}
+
+	state.PauseUpdates = types.BoolValue(cluster.PauseUpdates)
+	if cluster.MaintenanceStartTime == 0 {
+		state.MaintenanceWindow = types.ObjectNull(maintenanceWindowAttrTypes)
+		return 0
+	}
+	window := utils.MaintenanceWindowFromEpochMillis(cluster.MaintenanceStartTime, cluster.MaintenanceEndTime)
+	windowModel := maintenanceWindowModel{
+		Day:      types.StringValue(window.Day),
+		Time:     types.StringValue(window.Time),
+		Duration: types.StringValue(window.Duration.String()),
+	}
+	// keep the duration as configured, when it's the same, like "4h" for "4h0m0s"
+	var existing maintenanceWindowModel
+	if !state.MaintenanceWindow.IsNull() && !state.MaintenanceWindow.IsUnknown() {
+		if diags = state.MaintenanceWindow.As(*ctx, &existing, basetypes.ObjectAsOptions{}); !diags.HasError() {
+			if duration, err := time.ParseDuration(existing.Duration.ValueString()); err == nil && duration == window.Duration {
+				windowModel.Duration = existing.Duration
+			}
+		}
+	}
+	state.MaintenanceWindow, diags = types.ObjectValueFrom(*ctx, maintenanceWindowAttrTypes, windowModel)
+	if diagnostics.Append(diags...); diagnostics.HasError() {
+		return 1
+	}
 	return 0
 }
 
-func (r *clusterResource) validateInputs(ctx *context.Context, diags *diag.Diagnostics, tfPlan *clusterResourceModel) {
-	tflog.Info(*ctx, "validating inputs")
-	if tfPlan.ServiceType.ValueString() != service_type.POSTGRES && tfPlan.InstanceSize.ValueString() == "SMALL-LITE" {
-		diags.AddAttributeError(path.Root("instance_size"),
-			"Invalid input", fmt.Sprintf("Instance Size \"%s\" is not available for Service \"%s\"", tfPlan.InstanceSize.ValueString(), tfPlan.ServiceType.ValueString()))
+// saveConnection builds the connection details of cluster, null until cluster reports its connection URI.
+func (r *clusterResource) saveConnection(ctx context.Context, diagnostics *diag.Diagnostics, state *clusterResourceModel, cluster *model.Cluster) {
+	state.Connection = types.ObjectNull(connectionAttrTypes)
+	if cluster.Metadata == nil || cluster.Metadata.ConnectionUri == "" {
+		return
+	}
+	var username, password, database string
+	if state.ClusterMetadata != nil {
+		username = state.ClusterMetadata.Username.ValueString()
+		password = state.ClusterMetadata.Password.ValueString()
+		database = state.ClusterMetadata.Database.ValueString()
+	}
+	connection, err := utils.BuildConnection(cluster.ServiceType, cluster.Metadata.ConnectionUri, username, password, database)
+	if err != nil {
+		tflog.Warn(ctx, "could not build connection details of cluster", map[string]interface{}{"error": err.Error()})
+		return
+	}
+	connectionObject, diags := types.ObjectValueFrom(ctx, connectionAttrTypes, connectionModel{
+		Host:     types.StringValue(connection.Host),
+		Port:     types.Int64Value(connection.Port),
+		Username: types.StringValue(connection.Username),
+		Password: types.StringValue(connection.Password),
+		Database: types.StringValue(connection.Database),
+		Uri:      types.StringValue(connection.Uri),
+		JdbcUrl:  types.StringValue(connection.JdbcUrl),
+		Dsn:      types.StringValue(connection.Dsn),
+	})
+	if diagnostics.Append(diags...); diagnostics.HasError() {
 		return
 	}
-	if tfPlan.ServiceType.ValueString() == service_type.RABBITMQ {
+	state.Connection = connectionObject
+}
+
+// buildUpdateRequest converts the updatable attributes of plan to API request.
+func (r *clusterResource) buildUpdateRequest(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) *controller.ClusterUpdateRequest {
+	var updateRequest controller.ClusterUpdateRequest
+	plan.Tags.ElementsAs(ctx, &updateRequest.Tags, true)
+	if !plan.PauseUpdates.IsNull() && !plan.PauseUpdates.IsUnknown() {
+		updateRequest.PauseUpdates = plan.PauseUpdates.ValueBoolPointer()
+	}
+	window := r.maintenanceWindowOf(ctx, diags, plan)
+	if window != nil {
+		updateRequest.MaintenanceStartTime, updateRequest.MaintenanceEndTime = window.EpochMillis(time.Now())
+	}
+	return &updateRequest
+}
+
+// maintenanceWindowOf returns the maintenance window of the plan/state, nil if it's not known.
+func (r *clusterResource) maintenanceWindowOf(ctx context.Context, diags *diag.Diagnostics, tfModel *clusterResourceModel) *utils.MaintenanceWindow {
+	if tfModel.MaintenanceWindow.IsNull() || tfModel.MaintenanceWindow.IsUnknown() {
+		return nil
+	}
+	var windowModel maintenanceWindowModel
+	if diags.Append(tfModel.MaintenanceWindow.As(ctx, &windowModel, basetypes.ObjectAsOptions{})...); diags.HasError() {
+		return nil
+	}
+	window, err := utils.ParseMaintenanceWindow(windowModel.Day.ValueString(), windowModel.Time.ValueString(), windowModel.Duration.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("maintenance_window"), "Invalid input", err.Error())
+		return nil
+	}
+	return window
+}
+
+// serviceType returns the planned service type with its capabilities, nil if it's not known yet.
+func (r *clusterResource) serviceType(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) *model.ServiceType {
+	if plan.ServiceType.IsUnknown() {
+		return nil
+	}
+	serviceType := utils.GetServiceType(ctx, r.client, plan.ServiceType.ValueString())
+	if serviceType == nil {
+		diags.AddAttributeError(path.Root("service_type"), "Invalid input",
+			fmt.Sprintf("Service type %q is not enabled on TDH, supported values: %q",
+				plan.ServiceType.ValueString(), utils.GetServiceTypeNames(ctx, r.client)))
+	}
+	return serviceType
+}
+
+func (r *clusterResource) validateInputs(ctx *context.Context, diags *diag.Diagnostics, tfPlan *clusterResourceModel) {
+	tflog.Info(*ctx, "validating inputs")
+	serviceType := r.serviceType(*ctx, diags, tfPlan)
+	if serviceType == nil || !serviceType.CredentialsRequired {
 		return
 	}
 	if tfPlan.ClusterMetadata == nil {
@@ -673,7 +2471,7 @@ func (r *clusterResource) validateInputs(ctx *context.Context, diags *diag.Diagn
 		return
 	}
 
-	if tfPlan.ServiceType.ValueString() != service_type.REDIS {
+	if serviceType.DatabaseRequired {
 		if tfPlan.ClusterMetadata.Database.IsNull() || tfPlan.ClusterMetadata.Database.ValueString() == "" || strings.TrimSpace(tfPlan.ClusterMetadata.Database.ValueString()) == "" {
 			diags.AddAttributeError(path.Root("cluster_metadata").AtName("database"),
 				"Invalid input", fmt.Sprintf("Service \"%s\" requires database attribute.", tfPlan.ServiceType.ValueString()))
@@ -690,3 +2488,67 @@ func (r *clusterResource) validateInputs(ctx *context.Context, diags *diag.Diagn
 	}
 
 }
+
+// resolveSourceBackup returns the backup to create the cluster from, nil if cluster isn't a clone.
+func (r *clusterResource) resolveSourceBackup(ctx context.Context, diags *diag.Diagnostics, plan *clusterResourceModel) *model.ClusterBackup {
+	var backup *model.ClusterBackup
+	switch {
+	case !plan.SourceBackupId.IsNull() && !plan.SourceBackupId.IsUnknown():
+		found, err := r.client.Controller.GetBackup(plan.SourceBackupId.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("source_backup_id"), "Invalid input",
+				"Could not fetch the source backup, unexpected error: "+err.Error())
+			return nil
+		}
+		backup = found
+	case !plan.SourceClusterId.IsNull():
+		if !plan.MostRecentBackup.ValueBool() {
+			diags.AddAttributeError(path.Root("most_recent_backup"), "Invalid input",
+				"Only the most recent backup of source cluster can be used, set this to `true` or use `source_backup_id` instead.")
+			return nil
+		}
+		backups, err := r.client.Controller.GetAllClusterBackups(&controller.BackupsQuery{
+			ClusterId: plan.SourceClusterId.ValueString(),
+		})
+		if err != nil {
+			diags.AddAttributeError(path.Root("source_cluster_id"), "Invalid input",
+				"Could not fetch backups of the source cluster, unexpected error: "+err.Error())
+			return nil
+		}
+		var latestCompleted time.Time
+		for i := range backups {
+			completed, err := time.Parse(time.RFC3339, backups[i].TimeCompleted)
+			if err != nil || !slices.Contains(successfulBackupStatuses, backups[i].Status) {
+				continue
+			}
+			if backup == nil || completed.After(latestCompleted) {
+				backup, latestCompleted = &backups[i], completed
+			}
+		}
+		if backup == nil {
+			diags.AddAttributeError(path.Root("source_cluster_id"), "Invalid input",
+				fmt.Sprintf("Cluster %q has no completed backup to create the cluster from.", plan.SourceClusterId.ValueString()))
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	if !slices.Contains(successfulBackupStatuses, backup.Status) {
+		diags.AddError("Invalid input",
+			fmt.Sprintf("Backup %q has status %q, only a completed backup can be used to create a cluster.", backup.Id, backup.Status))
+		return nil
+	}
+	if backup.ServiceType != plan.ServiceType.ValueString() {
+		diags.AddError("Invalid input",
+			fmt.Sprintf("Backup %q is of service type %q, it can't be used to create a %q cluster.", backup.Id, backup.ServiceType, plan.ServiceType.ValueString()))
+		return nil
+	}
+	if serviceType := utils.GetServiceType(ctx, r.client, backup.ServiceType); serviceType == nil || !serviceType.CloneSupported {
+		diags.AddError("Invalid input",
+			fmt.Sprintf("Creating a cluster from backup isn't supported for service type %q.", backup.ServiceType))
+		return nil
+	}
+	tflog.Info(ctx, "creating cluster from backup", map[string]interface{}{"backup_id": backup.Id})
+	return backup
+}
@@ -100,7 +100,7 @@ func (r *clusterBackupResource) Schema(ctx context.Context, _ resource.SchemaReq
 	tflog.Info(ctx, "INIT__Schema")
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "This is used to create backup (and restore a backup) of a database service cluster like `POSTGRES`, `MYSQL`, `REDIS`.\n" +
-			"**Note:** To restore a backup, either create a backup or import by ID.",
+			"**Note:** To restore a backup, either create a backup or import by ID. Resource `tdh_cluster_restore` can be used instead, to plan & track restores.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID of the backup.",
@@ -392,7 +392,7 @@ func (r *clusterBackupResource) Create(ctx context.Context, req resource.CreateR
 		)
 		return
 	}
-	err = utils.WaitForTask(r.client, response.TaskId)
+	err = utils.WaitForTask(ctx, r.client, response.TaskId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Creating cluster backup",
@@ -518,7 +518,7 @@ func (r *clusterBackupResource) Delete(ctx context.Context, request resource.Del
 		)
 		return
 	}
-	err = utils.WaitForTask(r.client, response.TaskId)
+	err = utils.WaitForTask(ctx, r.client, response.TaskId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Deleting cluster backup",
@@ -642,61 +642,30 @@ func (r *clusterBackupResource) triggerRestoreAndWait(ctx *context.Context, diag
 	}()
 	var restoreInfo RestoreInfoModel
 	plan.Restore.As(*ctx, &restoreInfo, basetypes.ObjectAsOptions{})
-	var metadataModel BackupMetadata
-	state.Metadata.As(*ctx, &metadataModel, basetypes.ObjectAsOptions{})
-	request := controller.ClusterCreateRequest{
-		Name:              restoreInfo.ClusterName.ValueString(),
-		StoragePolicyName: restoreInfo.StoragePolicy.ValueString(),
-		NetworkPolicyIds:  restoreInfo.NetworkPolicyIds,
-		Region:            state.Region.ValueString(),
-		Provider:          state.Provider.ValueString(),
-		Version:           state.ClusterVersion.ValueString(),
-		InstanceSize:      metadataModel.ClusterSize.ValueString(),
-		ServiceType:       state.ServiceType.ValueString(),
-		Tags:              restoreInfo.Tags,
+	backup, err := r.client.Controller.GetBackup(state.ID.ValueString())
+	if err != nil {
+		diags.AddError("Restoring cluster backup", "Could not fetch required details: "+err.Error())
+		return
 	}
-	if state.ServiceType.ValueString() == service_type.REDIS {
-		request.Name = state.ClusterName.ValueString()
-		request.StoragePolicyName = "dummy"
-		request.NetworkPolicyIds = append(request.NetworkPolicyIds, "dummy")
-	}
-	tflog.Debug(*ctx, "initial req", map[string]interface{}{"req": request})
-	var extNames []string
-	for _, extension := range metadataModel.Extensions {
-		extNames = append(extNames, extension.Name.ValueString())
-	}
-	clusterMetadata := controller.ClusterMetadata{
-		Username:    "tdh_internal_user",
-		Password:    "********",
-		RestoreFrom: state.ID.ValueString(),
-		Extensions:  []string{}, // sending exact extensions fails for some reason
-	}
-	if len(metadataModel.Databases) > 0 {
-		clusterMetadata.Database = metadataModel.Databases[0]
-	}
-	request.ClusterMetadata = clusterMetadata
-	tflog.Debug(*ctx, "req with metadata", map[string]interface{}{"req": request})
-	dataPlane, err := r.client.InfraConnector.GetDataPlaneById(state.DataPlaneId.ValueString())
+	// Redis backups are restored only in-place
+	request, err := utils.NewRestoreRequest(r.client, backup, utils.RestoreTarget{
+		ClusterName:      restoreInfo.ClusterName.ValueString(),
+		StoragePolicy:    restoreInfo.StoragePolicy.ValueString(),
+		NetworkPolicyIds: restoreInfo.NetworkPolicyIds,
+		Tags:             restoreInfo.Tags,
+		InPlace:          state.ServiceType.ValueString() == service_type.REDIS,
+	})
 	if err != nil {
 		diags.AddError("Restoring cluster backup", "Could not fetch required details: "+err.Error())
 		return
 	}
-	tflog.Debug(*ctx, "fetched data plane", map[string]interface{}{"dp": dataPlane})
-	request.Shared = dataPlane.Shared
-	request.Dedicated = len(dataPlane.OrgId) != 0
-	tflog.Debug(*ctx, "req after dp details", map[string]interface{}{"req": request})
-	var api func(request *controller.ClusterCreateRequest) (*model.TaskResponse, error)
-	if request.ServiceType == service_type.POSTGRES {
-		api = r.client.Controller.CreateCluster
-	} else {
-		api = r.client.Controller.RestoreClusterBackup
-	}
-	response, err := api(&request)
+	tflog.Debug(*ctx, "restore req", map[string]interface{}{"req": request})
+	response, err := utils.RestoreApi(r.client, request.ServiceType)(request)
 	if err != nil {
 		diags.AddError("Restoring cluster backup", "Got error while submitting request: "+err.Error())
 		return
 	}
-	err = utils.WaitForTask(r.client, response.TaskId)
+	err = utils.WaitForTask(*ctx, r.client, response.TaskId)
 	if err != nil {
 		diags.AddError("Restoring cluster backup",
 			"Task responsible for this operation failed, error: "+err.Error())
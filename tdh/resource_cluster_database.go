@@ -0,0 +1,344 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+	"slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &clusterDatabaseResource{}
+	_ resource.ResourceWithImportState = &clusterDatabaseResource{}
+)
+
+// databaseServiceTypes are the service types whose clusters support managing databases.
+var databaseServiceTypes = []string{service_type.POSTGRES, service_type.MYSQL}
+
+func NewClusterDatabaseResource() resource.Resource {
+	return &clusterDatabaseResource{}
+}
+
+type clusterDatabaseResource struct {
+	client *tdh.Client
+}
+
+// clusterDatabaseResourceModel maps the resource schema data.
+type clusterDatabaseResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	Owner     types.String `tfsdk:"owner"`
+}
+
+func (r *clusterDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_database"
+}
+
+func (r *clusterDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterDatabaseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents a database on a `POSTGRES` or `MYSQL` cluster, in addition to the one created along with the cluster.\n" +
+			"## Notes\n" +
+			"- Changing `cluster_id` or `name` will force the database to be re-created, losing its data.\n" +
+			"- `owner` can be changed in place.\n" +
+			"- System databases like `postgres` or `mysql` can't be managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the database, in the form `<cluster_id>/<name>`, with the name URL-encoded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the database.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Name of the user owning the database. Defaults to the admin user of the cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+// Create a new resource
+func (r *clusterDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan clusterDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if slices.Contains(systemDatabases, plan.Name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"),
+			"Creating database",
+			fmt.Sprintf("%q is a system database, which can't be managed", plan.Name.ValueString()),
+		)
+		return
+	}
+	metaData, err := utils.GetClusterMetaData(r.client, plan.ClusterId.ValueString(), databaseServiceTypes...)
+	if err == nil && metaData == nil {
+		err = fmt.Errorf("cluster not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating database",
+			"Could not read cluster "+plan.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if findDatabase(metaData, plan.Name.ValueString()) != nil {
+		resp.Diagnostics.AddError(
+			"Creating database",
+			fmt.Sprintf("Database %q already exists on cluster, please import it instead", plan.Name.ValueString()),
+		)
+		return
+	}
+
+	taskResponse, err := r.client.Controller.CreateClusterDatabase(plan.ClusterId.ValueString(), &controller.ClusterDatabaseRequest{
+		Name:  plan.Name.ValueString(),
+		Owner: plan.Owner.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating database",
+			"Could not create database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating database",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(utils.JoinResourceId(plan.ClusterId.ValueString(), plan.Name.ValueString()))
+	if plan.Owner.IsUnknown() {
+		// owner chosen by the cluster is known once the database shows up in metadata
+		if err = r.refreshOwner(&plan); err != nil {
+			tflog.Warn(ctx, "Could not read owner of the created database: "+err.Error(), map[string]interface{}{"id": plan.ID.ValueString()})
+			plan.Owner = types.StringNull()
+		}
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *clusterDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state clusterDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, state.ClusterId.ValueString(), databaseServiceTypes...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading database",
+			"Could not read metadata of cluster "+state.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var database *model.DatabaseModel
+	if metaData != nil {
+		database = findDatabase(metaData, state.Name.ValueString())
+	}
+	if database == nil {
+		tflog.Warn(ctx, "Database not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(utils.JoinResourceId(state.ClusterId.ValueString(), state.Name.ValueString()))
+	if database.Owner != "" {
+		state.Owner = types.StringValue(database.Owner)
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *clusterDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// Retrieve values from plan
+	var state, plan clusterDatabaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// owner is the only attribute that can be changed in place
+	if !plan.Owner.IsUnknown() && !plan.Owner.Equal(state.Owner) {
+		taskResponse, err := r.client.Controller.UpdateClusterDatabase(plan.ClusterId.ValueString(), &controller.ClusterDatabaseRequest{
+			Name:  plan.Name.ValueString(),
+			Owner: plan.Owner.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Updating database",
+				"Could not change owner of database "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating database",
+				"Task responsible for this operation failed, error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *clusterDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state clusterDatabaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResponse, err := r.client.Controller.DeleteClusterDatabase(state.ClusterId.ValueString(), &controller.ClusterDatabaseRequest{
+		Name: state.Name.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Deleting database",
+			"Could not delete database "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting database",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *clusterDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.SplitResourceId(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing database",
+			"Expected import ID in the form <cluster_id>/<name>, error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.JoinResourceId(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// refreshOwner sets the owner of database as reported by cluster metadata.
+func (r *clusterDatabaseResource) refreshOwner(plan *clusterDatabaseResourceModel) error {
+	metaData, err := r.client.Controller.GetClusterMetaData(plan.ClusterId.ValueString())
+	if err != nil {
+		return err
+	}
+	database := findDatabase(metaData, plan.Name.ValueString())
+	if database == nil {
+		return fmt.Errorf("database %q not found in cluster metadata", plan.Name.ValueString())
+	}
+	plan.Owner = types.StringValue(database.Owner)
+	return nil
+}
+
+func findDatabase(metaData *model.ClusterMetaData, name string) *model.DatabaseModel {
+	for i := range metaData.Databases {
+		if metaData.Databases[i].Name == name {
+			return &metaData.Databases[i]
+		}
+	}
+	return nil
+}
@@ -122,7 +122,7 @@ func (r *clusterNetworkPoliciesAssociationResource) Create(ctx context.Context,
 	}
 	// this operation usually happens instantly
 	time.Sleep(2 * time.Second)
-	err = utils.WaitForTask(r.client, response.TaskId)
+	err = utils.WaitForTask(ctx, r.client, response.TaskId)
 	if err != nil {
 		resp.Diagnostics.AddError("Creating cluster network policies association",
 			"Task responsible for this operation failed, error: "+err.Error(),
@@ -200,7 +200,7 @@ func (r *clusterNetworkPoliciesAssociationResource) Update(ctx context.Context,
 	}
 	// this operation usually happens instantly
 	time.Sleep(2 * time.Second)
-	err = utils.WaitForTask(r.client, response.TaskId)
+	err = utils.WaitForTask(ctx, r.client, response.TaskId)
 	if err != nil {
 		resp.Diagnostics.AddError("Updating cluster network policies association",
 			"Task responsible for this operation failed, error: "+err.Error(),
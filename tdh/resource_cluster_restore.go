@@ -0,0 +1,428 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+	"slices"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterRestoreResource{}
+	_ resource.ResourceWithConfigure   = &clusterRestoreResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterRestoreResource{}
+	_ resource.ResourceWithImportState = &clusterRestoreResource{}
+)
+
+func NewClusterRestoreResource() resource.Resource {
+	return &clusterRestoreResource{}
+}
+
+type clusterRestoreResource struct {
+	client *tdh.Client
+}
+
+// clusterRestoreResourceModel maps the resource schema data.
+type clusterRestoreResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	BackupId         types.String `tfsdk:"backup_id"`
+	InPlace          types.Bool   `tfsdk:"in_place"`
+	ClusterName      types.String `tfsdk:"cluster_name"`
+	StoragePolicy    types.String `tfsdk:"storage_policy"`
+	NetworkPolicyIds types.Set    `tfsdk:"network_policy_ids"`
+	Tags             types.Set    `tfsdk:"tags"`
+	ServiceType      types.String `tfsdk:"service_type"`
+	ClusterId        types.String `tfsdk:"cluster_id"`
+}
+
+func (r *clusterRestoreResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_restore"
+}
+
+func (r *clusterRestoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterRestoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents a restore of a cluster backup, either into a new cluster or in-place onto the cluster it was taken of.\n" +
+			"## Notes\n" +
+			"- Changing any of the attributes will force the backup to be restored again.\n" +
+			"- Deleting it only removes it from the state, the restored cluster is left as is. It can be managed by importing it as `tdh_cluster`, using `cluster_id`.\n" +
+			"- `REDIS` backups can only be restored in-place, backups of other services only into a new cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the restore.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Description: "ID of the completed backup to restore.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"in_place": schema.BoolAttribute{
+				MarkdownDescription: "Whether to restore the backup onto the cluster it was taken of, there will be some downtime. " +
+					"Either this or `cluster_name` is required. Default is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the new cluster to restore the backup into. When restored in-place, it's the name of the cluster the backup was taken of.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("storage_policy"), path.MatchRoot("network_policy_ids")),
+				},
+			},
+			"storage_policy": schema.StringAttribute{
+				Description: "Name of the storage policy for the new cluster.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(utils.ReplaceStringUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("cluster_name")),
+				},
+			},
+			"network_policy_ids": schema.SetAttribute{
+				Description: "IDs of network policies to attach to the new cluster.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(utils.ReplaceSetUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AlsoRequires(path.MatchRoot("cluster_name")),
+				},
+			},
+			"tags": schema.SetAttribute{
+				Description: "Set of tags to set on the new cluster.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(utils.ReplaceSetUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.Set{
+					setvalidator.AlsoRequires(path.MatchRoot("cluster_name")),
+				},
+			},
+			"service_type": schema.StringAttribute{
+				Description: "Type of the service the backup is of.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the restored cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+func (r *clusterRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// only the target has to be validated, while creating
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+	var plan clusterRestoreResourceModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+	// being computed, the name is unknown in the plan when it's not configured
+	var clusterName types.String
+	if resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_name"), &clusterName)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.InPlace.IsUnknown() || clusterName.IsUnknown() {
+		return
+	}
+	if plan.InPlace.ValueBool() && !clusterName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_name"), "Invalid input",
+			"Backup restored in-place goes onto the cluster it was taken of, the name of new cluster can't be specified.")
+		return
+	}
+	if !plan.InPlace.ValueBool() && clusterName.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("cluster_name"), "Invalid input",
+			"Either this is required for restoring the backup into a new cluster, or \"in_place\" has to be true.")
+	}
+}
+
+// Create a new resource
+func (r *clusterRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan clusterRestoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.Controller.GetBackup(plan.BackupId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Restoring cluster backup",
+			"Could not read backup "+plan.BackupId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if r.validateBackup(&resp.Diagnostics, &plan, backup); resp.Diagnostics.HasError() {
+		return
+	}
+	request := r.buildRestoreRequest(ctx, &resp.Diagnostics, &plan, backup)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "submitting restore", map[string]interface{}{"backup_id": backup.Id, "cluster_name": request.Name})
+	taskResponse, err := utils.RestoreApi(r.client, request.ServiceType)(request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Restoring cluster backup",
+			"Could not submit request to restore backup, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Restoring cluster backup",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ServiceType = types.StringValue(backup.ServiceType)
+	plan.ClusterName = types.StringValue(request.Name)
+	plan.ClusterId = types.StringNull()
+	if plan.InPlace.ValueBool() {
+		plan.ClusterId = types.StringValue(backup.ClusterId)
+	} else if taskDto, err := r.client.TaskService.GetTask(taskResponse.TaskId); err == nil && taskDto.UiParams.ResourceId != "" {
+		plan.ClusterId = types.StringValue(taskDto.UiParams.ResourceId)
+	}
+	plan.ID = types.StringUnknown()
+	if r.refresh(ctx, &resp.Diagnostics, &plan); resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ID.IsUnknown() {
+		// the restore is done, so it's not submitted again; the record is looked up again on next refresh
+		plan.ID = types.StringValue(taskResponse.TaskId)
+		resp.Diagnostics.AddWarning("Restore not listed yet",
+			fmt.Sprintf("Backup has been restored, but the restore isn't listed yet. ID of the task [%s] is used until it is.", taskResponse.TaskId),
+		)
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *clusterRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state clusterRestoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.refresh(ctx, &resp.Diagnostics, &state); resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *clusterRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// attributes force restoring again, except the ones adopted from config after import
+	var plan clusterRestoreResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.ClearImported(ctx, resp.Private)...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *clusterRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// a restore can't be undone, the restored cluster is left for tdh_cluster to manage
+	var state clusterRestoreResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "removing restore from state, restored cluster is left as is", map[string]interface{}{"cluster_id": state.ClusterId.ValueString()})
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *clusterRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute, rest is read from the restore
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(utils.MarkImported(ctx, resp.Private)...)
+}
+
+// validateBackup makes sure the backup is completed & can be restored to the planned target.
+func (r *clusterRestoreResource) validateBackup(diags *diag.Diagnostics, plan *clusterRestoreResourceModel, backup *model.ClusterBackup) {
+	if !slices.Contains(successfulBackupStatuses, backup.Status) {
+		diags.AddAttributeError(path.Root("backup_id"), "Invalid input",
+			fmt.Sprintf("Backup %q has status %q, only completed backups can be restored.", backup.Id, backup.Status))
+		return
+	}
+	if backup.ServiceType == service_type.REDIS && !plan.InPlace.ValueBool() {
+		diags.AddAttributeError(path.Root("in_place"), "Invalid input",
+			fmt.Sprintf("Backups of service type %q can only be restored in-place.", backup.ServiceType))
+		return
+	}
+	if backup.ServiceType != service_type.REDIS && plan.InPlace.ValueBool() {
+		diags.AddAttributeError(path.Root("in_place"), "Invalid input",
+			fmt.Sprintf("Backups of service type %q can only be restored into a new cluster.", backup.ServiceType))
+	}
+}
+
+// buildRestoreRequest builds the request to restore the backup, the new cluster gets the same size, version & data plane as the backed up one.
+func (r *clusterRestoreResource) buildRestoreRequest(ctx context.Context, diags *diag.Diagnostics, plan *clusterRestoreResourceModel, backup *model.ClusterBackup) *controller.ClusterCreateRequest {
+	target := utils.RestoreTarget{
+		ClusterName:   plan.ClusterName.ValueString(),
+		StoragePolicy: plan.StoragePolicy.ValueString(),
+		InPlace:       plan.InPlace.ValueBool(),
+	}
+	if diags.Append(plan.NetworkPolicyIds.ElementsAs(ctx, &target.NetworkPolicyIds, true)...); diags.HasError() {
+		return nil
+	}
+	if diags.Append(plan.Tags.ElementsAs(ctx, &target.Tags, true)...); diags.HasError() {
+		return nil
+	}
+	request, err := utils.NewRestoreRequest(r.client, backup, target)
+	if err != nil {
+		diags.AddError("Restoring cluster backup", "Could not build request to restore backup, unexpected error: "+err.Error())
+		return nil
+	}
+	return request
+}
+
+// refresh updates the model from the restore, found by its ID or else by the backup & target cluster.
+// The model is kept as is when the restore isn't listed, since restoring again isn't the way to reconcile it.
+func (r *clusterRestoreResource) refresh(ctx context.Context, diags *diag.Diagnostics, restoreModel *clusterRestoreResourceModel) {
+	restores, err := r.client.Controller.GetAllClusterRestores(controller.RestoreQuery{
+		ServiceType: restoreModel.ServiceType.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "restores not found, keeping the state", map[string]interface{}{"id": restoreModel.ID.ValueString()})
+			return
+		}
+		diags.AddError("Reading cluster restore",
+			"Could not fetch restores, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	restore := findRestore(restores, restoreModel)
+	if restore == nil {
+		tflog.Warn(ctx, "restore not listed, keeping the state", map[string]interface{}{"id": restoreModel.ID.ValueString()})
+		return
+	}
+
+	restoreModel.ID = types.StringValue(restore.Id)
+	restoreModel.BackupId = types.StringValue(restore.BackupId)
+	restoreModel.ServiceType = types.StringValue(restore.ServiceType)
+	restoreModel.ClusterName = types.StringValue(restore.TargetInstanceName)
+	if restore.TargetInstance != "" {
+		restoreModel.ClusterId = types.StringValue(restore.TargetInstance)
+	}
+	if restoreModel.InPlace.IsNull() {
+		restoreModel.InPlace = types.BoolValue(restore.ServiceType == service_type.REDIS)
+	}
+}
+
+// findRestore returns the restore having ID of the model, otherwise the last one of its backup into its target cluster.
+func findRestore(restores []model.ClusterRestore, restoreModel *clusterRestoreResourceModel) *model.ClusterRestore {
+	var found *model.ClusterRestore
+	for i := range restores {
+		restore := &restores[i]
+		if restore.Id == restoreModel.ID.ValueString() {
+			return restore
+		}
+		if restore.BackupId != restoreModel.BackupId.ValueString() {
+			continue
+		}
+		if restore.TargetInstance == restoreModel.ClusterId.ValueString() || restore.TargetInstanceName == restoreModel.ClusterName.ValueString() {
+			found = restore
+		}
+	}
+	return found
+}
@@ -0,0 +1,362 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterSchemaResource{}
+	_ resource.ResourceWithConfigure   = &clusterSchemaResource{}
+	_ resource.ResourceWithImportState = &clusterSchemaResource{}
+)
+
+func NewClusterSchemaResource() resource.Resource {
+	return &clusterSchemaResource{}
+}
+
+type clusterSchemaResource struct {
+	client *tdh.Client
+}
+
+// clusterSchemaResourceModel maps the resource schema data.
+type clusterSchemaResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Database  types.String `tfsdk:"database"`
+	Name      types.String `tfsdk:"name"`
+	Owner     types.String `tfsdk:"owner"`
+}
+
+func (r *clusterSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_schema"
+}
+
+func (r *clusterSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterSchemaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents a schema in a database of a `POSTGRES` cluster.\n" +
+			"## Notes\n" +
+			"- Changing `cluster_id`, `database` or `name` will force the schema to be re-created, losing its data.\n" +
+			"- `owner` can be changed in place.\n" +
+			"- MySQL doesn't distinguish schemas from databases, use `tdh_cluster_database` for `MYSQL` clusters instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the schema, in the form `<cluster_id>/<database>/<name>`, with the database & name URL-encoded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"database": schema.StringAttribute{
+				Description: "Name of the database to create the schema in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the schema.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Name of the user owning the schema. Defaults to the admin user of the cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+// Create a new resource
+func (r *clusterSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan clusterSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, plan.ClusterId.ValueString(), service_type.POSTGRES)
+	if err == nil && metaData == nil {
+		err = fmt.Errorf("cluster not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating schema",
+			"Could not read cluster "+plan.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	database := findDatabase(metaData, plan.Database.ValueString())
+	if database == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("database"),
+			"Creating schema",
+			fmt.Sprintf("Database %q doesn't exist on cluster", plan.Database.ValueString()),
+		)
+		return
+	}
+	if findSchema(database, plan.Name.ValueString()) != nil {
+		resp.Diagnostics.AddError(
+			"Creating schema",
+			fmt.Sprintf("Schema %q already exists in database %q, please import it instead", plan.Name.ValueString(), plan.Database.ValueString()),
+		)
+		return
+	}
+
+	taskResponse, err := r.client.Controller.CreateClusterSchema(plan.ClusterId.ValueString(), &controller.ClusterSchemaRequest{
+		Database: plan.Database.ValueString(),
+		Name:     plan.Name.ValueString(),
+		Owner:    plan.Owner.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating schema",
+			"Could not create schema, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating schema",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(utils.JoinResourceId(plan.ClusterId.ValueString(), plan.Database.ValueString(), plan.Name.ValueString()))
+	if plan.Owner.IsUnknown() {
+		// owner chosen by the cluster is known once the schema shows up in metadata
+		if err = r.refreshOwner(&plan); err != nil {
+			tflog.Warn(ctx, "Could not read owner of the created schema: "+err.Error(), map[string]interface{}{"id": plan.ID.ValueString()})
+			plan.Owner = types.StringNull()
+		}
+	}
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *clusterSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state clusterSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, state.ClusterId.ValueString(), service_type.POSTGRES)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading schema",
+			"Could not read metadata of cluster "+state.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	var dbSchema *model.SchemaModel
+	if metaData != nil {
+		if database := findDatabase(metaData, state.Database.ValueString()); database != nil {
+			dbSchema = findSchema(database, state.Name.ValueString())
+		}
+	}
+	if dbSchema == nil {
+		tflog.Warn(ctx, "Schema not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(utils.JoinResourceId(state.ClusterId.ValueString(), state.Database.ValueString(), state.Name.ValueString()))
+	if dbSchema.Owner != "" {
+		state.Owner = types.StringValue(dbSchema.Owner)
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *clusterSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// Retrieve values from plan
+	var state, plan clusterSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+	diags = req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// owner is the only attribute that can be changed in place
+	if !plan.Owner.IsUnknown() && !plan.Owner.Equal(state.Owner) {
+		taskResponse, err := r.client.Controller.UpdateClusterSchema(plan.ClusterId.ValueString(), &controller.ClusterSchemaRequest{
+			Database: plan.Database.ValueString(),
+			Name:     plan.Name.ValueString(),
+			Owner:    plan.Owner.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Updating schema",
+				"Could not change owner of schema "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+			resp.Diagnostics.AddError(
+				"Updating schema",
+				"Task responsible for this operation failed, error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *clusterSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state clusterSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResponse, err := r.client.Controller.DeleteClusterSchema(state.ClusterId.ValueString(), &controller.ClusterSchemaRequest{
+		Database: state.Database.ValueString(),
+		Name:     state.Name.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Deleting schema",
+			"Could not delete schema "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting schema",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *clusterSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.SplitResourceId(req.ID, 3)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing schema",
+			"Expected import ID in the form <cluster_id>/<database>/<name>, error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.JoinResourceId(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+// refreshOwner sets the owner of schema as reported by cluster metadata.
+func (r *clusterSchemaResource) refreshOwner(plan *clusterSchemaResourceModel) error {
+	metaData, err := r.client.Controller.GetClusterMetaData(plan.ClusterId.ValueString())
+	if err != nil {
+		return err
+	}
+	database := findDatabase(metaData, plan.Database.ValueString())
+	if database == nil {
+		return fmt.Errorf("database %q not found in cluster metadata", plan.Database.ValueString())
+	}
+	dbSchema := findSchema(database, plan.Name.ValueString())
+	if dbSchema == nil {
+		return fmt.Errorf("schema %q not found in cluster metadata", plan.Name.ValueString())
+	}
+	plan.Owner = types.StringValue(dbSchema.Owner)
+	return nil
+}
+
+func findSchema(database *model.DatabaseModel, name string) *model.SchemaModel {
+	for i := range database.Schemas {
+		if database.Schemas[i].Name == name {
+			return &database.Schemas[i]
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package tdh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/infra-connector"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
@@ -334,31 +336,26 @@ func (r *dataPlaneResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	err = utils.WaitForTask(r.client, taskResponse.TaskId)
+	pendingTask := &utils.PendingTask{
+		TaskId:       taskResponse.TaskId,
+		ResourceName: plan.Name.ValueString(),
+	}
+	err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId)
 	if err != nil {
+		if utils.IsInterrupted(err) {
+			r.savePendingCreation(ctx, resp, &plan, pendingTask)
+			return
+		}
 		resp.Diagnostics.AddError("Creating data plane",
 			"Task responsible for this operation failed, error: "+err.Error(),
 		)
 		return
 	}
 
-	dataPlanes, err := r.client.InfraConnector.GetDataPlanes(&infra_connector.DataPlanesQuery{
-		Name: plan.Name.ValueString(),
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Fetching Data Plane",
-			"Could not fetch data plane, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	if len(*dataPlanes.Get()) <= 0 {
-		resp.Diagnostics.AddError("Fetching Data Plane",
-			"Unable to fetch the created data plane",
-		)
+	createdDataPlane := r.fetchCreatedDataPlane(ctx, &resp.Diagnostics, pendingTask)
+	if createdDataPlane == nil {
 		return
 	}
-	createdDataPlane := &(*dataPlanes.Get())[0]
 	tflog.Debug(ctx, "Created data plane", map[string]interface{}{"dto": createdDataPlane})
 	if saveFromDataPlaneResponse(&ctx, &resp.Diagnostics, &plan, createdDataPlane) != 0 {
 		return
@@ -414,7 +411,7 @@ func (r *dataPlaneResource) Update(ctx context.Context, req resource.UpdateReque
 			)
 			return
 		}
-		if err = utils.WaitForTask(r.client, taskResponse.TaskId); err != nil {
+		if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
 			resp.Diagnostics.AddError("Updating data plane",
 				"Operation error: "+err.Error())
 			return
@@ -451,7 +448,7 @@ func (r *dataPlaneResource) Update(ctx context.Context, req resource.UpdateReque
 			)
 			return
 		}
-		if err = utils.WaitForTask(r.client, taskResponse.TaskId); err != nil {
+		if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
 			resp.Diagnostics.AddError("Updating data plane",
 				"Sync operation error: "+err.Error())
 			return
@@ -500,7 +497,7 @@ func (r *dataPlaneResource) Delete(ctx context.Context, request resource.DeleteR
 		)
 		return
 	}
-	err = utils.WaitForTask(r.client, taskResponse.TaskId)
+	err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId)
 	if err != nil {
 		resp.Diagnostics.AddError("Deleting data plane",
 			"Task responsible for this operation failed, error: "+err.Error(),
@@ -525,9 +522,24 @@ func (r *dataPlaneResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if pendingTask, dgs := utils.GetPendingTask(ctx, req.Private); dgs.HasError() || pendingTask != nil {
+		if resp.Diagnostics.Append(dgs...); resp.Diagnostics.HasError() {
+			return
+		}
+		r.resumePendingCreation(ctx, resp, &state, pendingTask)
+		return
+	}
+
 	// Get refreshed dataplane value
 	dataplane, err := r.client.InfraConnector.GetDataPlaneById(state.ID.ValueString())
 	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "data plane not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading Data Plane",
 			"Could not read data plane "+state.ID.ValueString()+": "+err.Error(),
@@ -551,6 +563,87 @@ func (r *dataPlaneResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Info(ctx, "END__Read")
 }
 
+// savePendingCreation saves what is known about the data plane being created, along with the task creating it,
+// so that next run resumes waiting on it instead of creating it again.
+func (r *dataPlaneResource) savePendingCreation(ctx context.Context, resp *resource.CreateResponse, plan *dataPlaneResourceModel, pendingTask *utils.PendingTask) {
+	tflog.Info(ctx, "waiting for data plane creation interrupted, saving pending task", map[string]interface{}{"task_id": pendingTask.TaskId})
+	if resp.Diagnostics.Append(utils.SavePendingTask(ctx, resp.Private, pendingTask)...); resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringNull()
+	plan.Status = types.StringNull()
+	if plan.Sync.IsUnknown() {
+		plan.Sync = types.BoolValue(false)
+	}
+	if resp.Diagnostics.Append(resp.State.Set(ctx, plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.AddWarning("Data plane creation still in progress",
+		fmt.Sprintf("Waiting for the task [ID: %s] was interrupted, the data plane %q may still get created. "+
+			"Next run will resume waiting on it and adopt the created data plane.", pendingTask.TaskId, pendingTask.ResourceName),
+	)
+}
+
+// resumePendingCreation waits on the creation task saved by an interrupted run, and adopts the created data plane.
+// The resource is removed from state if the task has failed, so that it gets created again.
+func (r *dataPlaneResource) resumePendingCreation(ctx context.Context, resp *resource.ReadResponse, state *dataPlaneResourceModel, pendingTask *utils.PendingTask) {
+	tflog.Info(ctx, "resuming wait on pending data plane creation", map[string]interface{}{"task_id": pendingTask.TaskId})
+	if err := utils.WaitForTask(ctx, r.client, pendingTask.TaskId); err != nil {
+		var taskErr *utils.TaskFailedError
+		if errors.As(err, &taskErr) {
+			resp.Diagnostics.AddWarning("Data plane creation failed",
+				fmt.Sprintf("Creation of data plane %q that was pending since an interrupted run has failed, it will be created again. Error: %s",
+					pendingTask.ResourceName, err.Error()),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Reading Data Plane",
+			"Could not resume waiting on the data plane creation, error: "+err.Error(),
+		)
+		return
+	}
+	dataPlane := r.fetchCreatedDataPlane(ctx, &resp.Diagnostics, pendingTask)
+	if dataPlane == nil {
+		return
+	}
+	state.Sync = types.BoolValue(false)
+	if saveFromDataPlaneResponse(&ctx, &resp.Diagnostics, state, dataPlane) != 0 {
+		return
+	}
+	if resp.Diagnostics.Append(resp.State.Set(ctx, state)...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(utils.ClearPendingTask(ctx, resp.Private)...)
+}
+
+// fetchCreatedDataPlane returns the data plane created by the given task, nil if it couldn't be found.
+// The data plane is looked up by the ID reported by the task, falling back to its exact name.
+func (r *dataPlaneResource) fetchCreatedDataPlane(ctx context.Context, diagnostics *diag.Diagnostics, pendingTask *utils.PendingTask) *model.DataPlane {
+	return utils.FetchCreated(ctx, diagnostics, r.client, pendingTask, utils.CreatedLookup[model.DataPlane]{
+		Kind: "data plane",
+		GetById: func(id string) (*model.DataPlane, error) {
+			dataPlane, err := r.client.InfraConnector.GetDataPlaneById(id)
+			return &dataPlane, err
+		},
+		ListByName: func(pendingTask *utils.PendingTask) ([]model.DataPlane, error) {
+			dataPlanes, err := r.client.InfraConnector.GetAllDataPlanes(&infra_connector.DataPlanesQuery{
+				Name: pendingTask.ResourceName,
+			})
+			var matches []model.DataPlane
+			for _, dataPlane := range dataPlanes {
+				if dataPlane.DataplaneName == pendingTask.ResourceName {
+					matches = append(matches, dataPlane)
+				}
+			}
+			return matches, err
+		},
+		IdOf: func(dataPlane *model.DataPlane) string {
+			return dataPlane.Id
+		},
+	})
+}
+
 func saveFromDataPlaneResponse(ctx *context.Context, diagnostics *diag.Diagnostics, state *dataPlaneResourceModel, dataPlane *model.DataPlane) int8 {
 	tflog.Info(*ctx, "Saving response to resourceModel state/plan", map[string]interface{}{"data-plane": *dataPlane})
 
@@ -184,7 +184,7 @@ func (r *localUserResource) Create(ctx context.Context, req resource.CreateReque
 
 	// local user operation usually happens instantly
 	time.Sleep(5 * time.Second)
-	if err = <-utils.WaitForTaskV2(r.client, (*response)[0].TaskId, nil, nil); err != nil {
+	if err = utils.WaitForTask(ctx, r.client, (*response)[0].TaskId); err != nil {
 		resp.Diagnostics.AddError("Creating local user",
 			"Could not create local user, error: "+err.Error(),
 		)
@@ -263,7 +263,7 @@ func (r *localUserResource) Update(ctx context.Context, req resource.UpdateReque
 	if len(*response) > 0 {
 		// local user operation usually happens instantly
 		time.Sleep(5 * time.Second)
-		if err = utils.WaitForAllTasks(r.client, *response); err != nil {
+		if err = utils.WaitForTask(ctx, r.client, utils.TaskIdsOf(*response)...); err != nil {
 			resp.Diagnostics.AddError("Updating local user",
 				"Could not update local user: "+err.Error(),
 			)
@@ -320,7 +320,7 @@ func (r *localUserResource) Delete(ctx context.Context, request resource.DeleteR
 	// local user operation usually happens instantly
 	time.Sleep(5 * time.Second)
 
-	if err = utils.WaitForAllTasks(r.client, *response); err != nil {
+	if err = utils.WaitForTask(ctx, r.client, utils.TaskIdsOf(*response)...); err != nil {
 		resp.Diagnostics.AddError("Deleting local user",
 			"Could not delete local user: "+err.Error(),
 		)
@@ -346,6 +346,13 @@ func (r *localUserResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get refreshed cluster value from TDH
 	user, err := r.client.CustomerMetadata.GetLocalUser(state.ID.ValueString())
 	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "local user not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading Local user",
 			"Could not read local user ID "+state.ID.ValueString()+": "+err.Error(),
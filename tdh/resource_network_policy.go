@@ -2,6 +2,7 @@ package tdh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,6 +17,7 @@ import (
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/policy_type"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/customer-metadata"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/task"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
@@ -249,7 +251,7 @@ func (r *networkPolicyResource) Update(ctx context.Context, req resource.UpdateR
 			)
 			return
 		}
-		if err = utils.WaitForTask(r.client, (*tasksResponse.Get())[0].Id); err != nil {
+		if err = utils.WaitForTask(ctx, r.client, (*tasksResponse.Get())[0].Id); err != nil {
 			resp.Diagnostics.AddError(
 				"Updating  Network Policy",
 				"Operation error: "+err.Error(),
@@ -320,6 +322,13 @@ func (r *networkPolicyResource) Read(ctx context.Context, req resource.ReadReque
 	// Get refreshed policy value from TDH
 	policy, err := r.client.CustomerMetadata.GetPolicy(state.ID.ValueString())
 	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "network policy not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading Network Policy",
 			"Could not read Network policy ID "+state.ID.ValueString()+": "+err.Error(),
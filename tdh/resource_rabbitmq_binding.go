@@ -0,0 +1,367 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rabbitMqBindingResource{}
+	_ resource.ResourceWithConfigure   = &rabbitMqBindingResource{}
+	_ resource.ResourceWithImportState = &rabbitMqBindingResource{}
+)
+
+var bindingDestinationTypes = []string{"queue", "exchange"}
+
+func NewRabbitMqBindingResource() resource.Resource {
+	return &rabbitMqBindingResource{}
+}
+
+type rabbitMqBindingResource struct {
+	client *tdh.Client
+}
+
+// rabbitMqBindingResourceModel maps the resource schema data.
+type rabbitMqBindingResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ClusterId       types.String `tfsdk:"cluster_id"`
+	VHost           types.String `tfsdk:"vhost"`
+	Source          types.String `tfsdk:"source"`
+	Destination     types.String `tfsdk:"destination"`
+	DestinationType types.String `tfsdk:"destination_type"`
+	RoutingKey      types.String `tfsdk:"routing_key"`
+	Arguments       types.Map    `tfsdk:"arguments"`
+}
+
+func (r *rabbitMqBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rabbitmq_binding"
+}
+
+func (r *rabbitMqBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *rabbitMqBindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents a binding from an exchange to a queue or another exchange on a RabbitMQ cluster.\n" +
+			"## Notes\n" +
+			"- Changing any of the attributes will force the binding to be re-created.\n" +
+			"- Cluster metadata doesn't report the arguments of a binding, so on the first apply after import, `arguments` are taken from the configuration without re-creating the binding.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the binding, in the form `<cluster_id>/<vhost>/<source>/<destination_type>/<destination>/<routing_key>`, with all the parts except cluster ID URL-encoded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the RabbitMQ cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"vhost": schema.StringAttribute{
+				Description: "Virtual host of the binding. Default is `/`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(utils.DefaultVHost),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "Name of the exchange to bind from.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description: "Name of the queue or exchange to bind to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"destination_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the destination, either `queue` or `exchange`. Default is `queue`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("queue"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(bindingDestinationTypes...),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				Description: "Routing key of the binding. Default is empty.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"arguments": schema.MapAttribute{
+				MarkdownDescription: "Optional arguments of the binding, like the ones matched by a `headers` exchange.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(utils.ReplaceMapUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+// Create a new resource
+func (r *rabbitMqBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan rabbitMqBindingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, plan.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err == nil && metaData == nil {
+		err = fmt.Errorf("cluster not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating binding",
+			"Could not read cluster "+plan.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if findVhost(metaData, plan.VHost.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("vhost"),
+			"Creating binding",
+			fmt.Sprintf("Virtual host %q doesn't exist on cluster", plan.VHost.ValueString()),
+		)
+		return
+	}
+	if findBinding(metaData, plan.bindingModel()) != nil {
+		resp.Diagnostics.AddError(
+			"Creating binding",
+			fmt.Sprintf("Binding from %q to %s %q already exists in virtual host %q, please import it instead",
+				plan.Source.ValueString(), plan.DestinationType.ValueString(), plan.Destination.ValueString(), plan.VHost.ValueString()),
+		)
+		return
+	}
+
+	requestBody := &controller.RabbitMqBindingRequest{
+		Source:          plan.Source.ValueString(),
+		VHost:           plan.VHost.ValueString(),
+		Destination:     plan.Destination.ValueString(),
+		DestinationType: plan.DestinationType.ValueString(),
+		RoutingKey:      plan.RoutingKey.ValueString(),
+	}
+	if resp.Diagnostics.Append(rabbitMqArguments(ctx, plan.Arguments, &requestBody.Arguments)...); resp.Diagnostics.HasError() {
+		return
+	}
+	taskResponse, err := r.client.Controller.CreateClusterBinding(plan.ClusterId.ValueString(), requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating binding",
+			"Could not create binding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating binding",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.id())
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *rabbitMqBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state rabbitMqBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, state.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading binding",
+			"Could not read metadata of cluster "+state.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if metaData == nil || findBinding(metaData, state.bindingModel()) == nil {
+		tflog.Warn(ctx, "Binding not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.id())
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *rabbitMqBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// only reached for arguments unknown since import, which are adopted from config as they are
+	var plan rabbitMqBindingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.ClearImported(ctx, resp.Private)...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *rabbitMqBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state rabbitMqBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResponse, err := r.client.Controller.DeleteClusterBinding(state.ClusterId.ValueString(), &controller.RabbitMqBindingRequest{
+		Source:          state.Source.ValueString(),
+		VHost:           state.VHost.ValueString(),
+		Destination:     state.Destination.ValueString(),
+		DestinationType: state.DestinationType.ValueString(),
+		RoutingKey:      state.RoutingKey.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Deleting binding",
+			"Could not delete binding "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting binding",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *rabbitMqBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.SplitResourceId(req.ID, 6)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing binding",
+			"Expected import ID in the form <cluster_id>/<vhost>/<source>/<destination_type>/<destination>/<routing_key>, error: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.JoinResourceId(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination_type"), parts[3])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination"), parts[4])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_key"), parts[5])...)
+	resp.Diagnostics.Append(utils.MarkImported(ctx, resp.Private)...)
+}
+
+func (m *rabbitMqBindingResourceModel) id() string {
+	return utils.JoinResourceId(m.ClusterId.ValueString(), m.VHost.ValueString(), m.Source.ValueString(),
+		m.DestinationType.ValueString(), m.Destination.ValueString(), m.RoutingKey.ValueString())
+}
+
+func (m *rabbitMqBindingResourceModel) bindingModel() model.BindingModel {
+	return model.BindingModel{
+		Source:          m.Source.ValueString(),
+		VHost:           m.VHost.ValueString(),
+		RoutingKey:      m.RoutingKey.ValueString(),
+		Destination:     m.Destination.ValueString(),
+		DestinationType: m.DestinationType.ValueString(),
+	}
+}
+
+func findBinding(metaData *model.ClusterMetaData, binding model.BindingModel) *model.BindingModel {
+	for i := range metaData.Bindings {
+		if metaData.Bindings[i] == binding {
+			return &metaData.Bindings[i]
+		}
+	}
+	return nil
+}
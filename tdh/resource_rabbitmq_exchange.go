@@ -0,0 +1,361 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rabbitMqExchangeResource{}
+	_ resource.ResourceWithConfigure   = &rabbitMqExchangeResource{}
+	_ resource.ResourceWithImportState = &rabbitMqExchangeResource{}
+)
+
+var exchangeTypes = []string{"direct", "fanout", "topic", "headers"}
+
+func NewRabbitMqExchangeResource() resource.Resource {
+	return &rabbitMqExchangeResource{}
+}
+
+type rabbitMqExchangeResource struct {
+	client *tdh.Client
+}
+
+// rabbitMqExchangeResourceModel maps the resource schema data.
+type rabbitMqExchangeResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ClusterId  types.String `tfsdk:"cluster_id"`
+	VHost      types.String `tfsdk:"vhost"`
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Durable    types.Bool   `tfsdk:"durable"`
+	AutoDelete types.Bool   `tfsdk:"auto_delete"`
+	Internal   types.Bool   `tfsdk:"internal"`
+	Arguments  types.Map    `tfsdk:"arguments"`
+}
+
+func (r *rabbitMqExchangeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rabbitmq_exchange"
+}
+
+func (r *rabbitMqExchangeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *rabbitMqExchangeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents an exchange on a RabbitMQ cluster.\n" +
+			"## Notes\n" +
+			"- Changing any of the attributes will force the exchange to be re-created, as RabbitMQ doesn't allow re-declaring an exchange with different properties.\n" +
+			"- Cluster metadata only reports the name & virtual host of an exchange, so on the first apply after import, `type`, `durable`, `auto_delete`, `internal` & `arguments` are taken from the configuration without re-creating the exchange.\n" +
+			"- The default exchange & the ones named `amq.*` are pre-declared by RabbitMQ and can't be managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the exchange, in the form `<cluster_id>/<vhost>/<name>`, with the virtual host & name URL-encoded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the RabbitMQ cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"vhost": schema.StringAttribute{
+				Description: "Virtual host of the exchange. Default is `/`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(utils.DefaultVHost),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the exchange.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the exchange, one of `direct`, `fanout`, `topic` & `headers`. Default is `direct`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("direct"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(utils.ReplaceStringUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(exchangeTypes...),
+				},
+			},
+			"durable": schema.BoolAttribute{
+				Description: "Whether the exchange survives a restart of the cluster. Default is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"auto_delete": schema.BoolAttribute{
+				Description: "Whether the exchange is deleted once its last binding is removed. Default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"internal": schema.BoolAttribute{
+				Description: "Whether the exchange can only receive messages from other exchanges, not from publishers. Default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"arguments": schema.MapAttribute{
+				MarkdownDescription: "Optional arguments of the exchange, like `alternate-exchange`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(utils.ReplaceMapUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+// Create a new resource
+func (r *rabbitMqExchangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan rabbitMqExchangeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, plan.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err == nil && metaData == nil {
+		err = fmt.Errorf("cluster not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating exchange",
+			"Could not read cluster "+plan.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if findVhost(metaData, plan.VHost.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("vhost"),
+			"Creating exchange",
+			fmt.Sprintf("Virtual host %q doesn't exist on cluster", plan.VHost.ValueString()),
+		)
+		return
+	}
+	if findExchange(metaData, plan.VHost.ValueString(), plan.Name.ValueString()) != nil {
+		resp.Diagnostics.AddError(
+			"Creating exchange",
+			fmt.Sprintf("Exchange %q already exists in virtual host %q, please import it instead", plan.Name.ValueString(), plan.VHost.ValueString()),
+		)
+		return
+	}
+
+	requestBody := &controller.RabbitMqExchangeRequest{
+		Name:       plan.Name.ValueString(),
+		VHost:      plan.VHost.ValueString(),
+		Type:       plan.Type.ValueString(),
+		Durable:    plan.Durable.ValueBool(),
+		AutoDelete: plan.AutoDelete.ValueBool(),
+		Internal:   plan.Internal.ValueBool(),
+	}
+	if resp.Diagnostics.Append(rabbitMqArguments(ctx, plan.Arguments, &requestBody.Arguments)...); resp.Diagnostics.HasError() {
+		return
+	}
+	taskResponse, err := r.client.Controller.CreateClusterExchange(plan.ClusterId.ValueString(), requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating exchange",
+			"Could not create exchange, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating exchange",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(utils.JoinResourceId(plan.ClusterId.ValueString(), plan.VHost.ValueString(), plan.Name.ValueString()))
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *rabbitMqExchangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state rabbitMqExchangeResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, state.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading exchange",
+			"Could not read metadata of cluster "+state.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if metaData == nil || findExchange(metaData, state.VHost.ValueString(), state.Name.ValueString()) == nil {
+		tflog.Warn(ctx, "Exchange not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(utils.JoinResourceId(state.ClusterId.ValueString(), state.VHost.ValueString(), state.Name.ValueString()))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *rabbitMqExchangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// only reached for properties unknown since import, which are adopted from config as they are
+	var plan rabbitMqExchangeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.ClearImported(ctx, resp.Private)...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *rabbitMqExchangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state rabbitMqExchangeResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResponse, err := r.client.Controller.DeleteClusterExchange(state.ClusterId.ValueString(), &controller.RabbitMqExchangeRequest{
+		Name:  state.Name.ValueString(),
+		VHost: state.VHost.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Deleting exchange",
+			"Could not delete exchange "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting exchange",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *rabbitMqExchangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.SplitResourceId(req.ID, 2, 3)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing exchange",
+			"Expected import ID in the form <cluster_id>/<vhost>/<name> or <cluster_id>/<name>, error: "+err.Error(),
+		)
+		return
+	}
+	if len(parts) == 2 {
+		parts = []string{parts[0], utils.DefaultVHost, parts[1]}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.JoinResourceId(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+	resp.Diagnostics.Append(utils.MarkImported(ctx, resp.Private)...)
+}
+
+func findExchange(metaData *model.ClusterMetaData, vHost string, name string) *model.ExchangeModel {
+	for i := range metaData.Exchanges {
+		if metaData.Exchanges[i].VHost == vHost && metaData.Exchanges[i].Name == name {
+			return &metaData.Exchanges[i]
+		}
+	}
+	return nil
+}
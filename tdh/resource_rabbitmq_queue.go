@@ -0,0 +1,341 @@
+package tdh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/utils"
+	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &rabbitMqQueueResource{}
+	_ resource.ResourceWithConfigure   = &rabbitMqQueueResource{}
+	_ resource.ResourceWithImportState = &rabbitMqQueueResource{}
+)
+
+func NewRabbitMqQueueResource() resource.Resource {
+	return &rabbitMqQueueResource{}
+}
+
+type rabbitMqQueueResource struct {
+	client *tdh.Client
+}
+
+// rabbitMqQueueResourceModel maps the resource schema data.
+type rabbitMqQueueResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ClusterId  types.String `tfsdk:"cluster_id"`
+	VHost      types.String `tfsdk:"vhost"`
+	Name       types.String `tfsdk:"name"`
+	Durable    types.Bool   `tfsdk:"durable"`
+	AutoDelete types.Bool   `tfsdk:"auto_delete"`
+	Arguments  types.Map    `tfsdk:"arguments"`
+}
+
+func (r *rabbitMqQueueResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rabbitmq_queue"
+}
+
+func (r *rabbitMqQueueResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*tdh.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *tdh.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Schema defines the schema for the resource.
+func (r *rabbitMqQueueResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Info(ctx, "INIT__Schema")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Represents a queue on a RabbitMQ cluster.\n" +
+			"## Notes\n" +
+			"- Changing any of the attributes will force the queue to be re-created, as RabbitMQ doesn't allow re-declaring a queue with different properties.\n" +
+			"- Cluster metadata only reports the name & virtual host of a queue, so on the first apply after import, `durable`, `auto_delete` & `arguments` are taken from the configuration without re-creating the queue.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the queue, in the form `<cluster_id>/<vhost>/<name>`, with the virtual host & name URL-encoded.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the RabbitMQ cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.UUIDValidator{},
+				},
+			},
+			"vhost": schema.StringAttribute{
+				Description: "Virtual host of the queue. Default is `/`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(utils.DefaultVHost),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the queue.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.EmptyStringValidator{},
+				},
+			},
+			"durable": schema.BoolAttribute{
+				Description: "Whether the queue survives a restart of the cluster. Default is `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"auto_delete": schema.BoolAttribute{
+				Description: "Whether the queue is deleted once its last consumer unsubscribes. Default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplaceIf(utils.ReplaceBoolUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+			"arguments": schema.MapAttribute{
+				MarkdownDescription: "Optional arguments of the queue, like `x-queue-type` or `x-message-ttl`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(utils.ReplaceMapUnlessImported, utils.ReplaceUnlessImportedDescription, utils.ReplaceUnlessImportedDescription),
+				},
+			},
+		},
+	}
+
+	tflog.Info(ctx, "END__Schema")
+}
+
+// Create a new resource
+func (r *rabbitMqQueueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "INIT__Create")
+	// Retrieve values from plan
+	var plan rabbitMqQueueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, plan.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err == nil && metaData == nil {
+		err = fmt.Errorf("cluster not found")
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating queue",
+			"Could not read cluster "+plan.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if findVhost(metaData, plan.VHost.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("vhost"),
+			"Creating queue",
+			fmt.Sprintf("Virtual host %q doesn't exist on cluster", plan.VHost.ValueString()),
+		)
+		return
+	}
+	if findQueue(metaData, plan.VHost.ValueString(), plan.Name.ValueString()) != nil {
+		resp.Diagnostics.AddError(
+			"Creating queue",
+			fmt.Sprintf("Queue %q already exists in virtual host %q, please import it instead", plan.Name.ValueString(), plan.VHost.ValueString()),
+		)
+		return
+	}
+
+	requestBody := &controller.RabbitMqQueueRequest{
+		Name:       plan.Name.ValueString(),
+		VHost:      plan.VHost.ValueString(),
+		Durable:    plan.Durable.ValueBool(),
+		AutoDelete: plan.AutoDelete.ValueBool(),
+	}
+	if resp.Diagnostics.Append(rabbitMqArguments(ctx, plan.Arguments, &requestBody.Arguments)...); resp.Diagnostics.HasError() {
+		return
+	}
+	taskResponse, err := r.client.Controller.CreateClusterQueue(plan.ClusterId.ValueString(), requestBody)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Creating queue",
+			"Could not create queue, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Creating queue",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(utils.JoinResourceId(plan.ClusterId.ValueString(), plan.VHost.ValueString(), plan.Name.ValueString()))
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Create")
+}
+
+func (r *rabbitMqQueueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "INIT__Read")
+	// Get current state
+	var state rabbitMqQueueResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	metaData, err := utils.GetClusterMetaData(r.client, state.ClusterId.ValueString(), service_type.RABBITMQ)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Reading queue",
+			"Could not read metadata of cluster "+state.ClusterId.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if metaData == nil || findQueue(metaData, state.VHost.ValueString(), state.Name.ValueString()) == nil {
+		tflog.Warn(ctx, "Queue not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(utils.JoinResourceId(state.ClusterId.ValueString(), state.VHost.ValueString(), state.Name.ValueString()))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "END__Read")
+}
+
+func (r *rabbitMqQueueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "INIT__Update")
+	// only reached for properties unknown since import, which are adopted from config as they are
+	var plan rabbitMqQueueResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(utils.ClearImported(ctx, resp.Private)...)
+
+	tflog.Info(ctx, "END__Update")
+}
+
+func (r *rabbitMqQueueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "INIT__Delete")
+	// Get current state
+	var state rabbitMqQueueResourceModel
+	diags := req.State.Get(ctx, &state)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskResponse, err := r.client.Controller.DeleteClusterQueue(state.ClusterId.ValueString(), &controller.RabbitMqQueueRequest{
+		Name:  state.Name.ValueString(),
+		VHost: state.VHost.ValueString(),
+	})
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Deleting queue",
+			"Could not delete queue "+state.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	if err = utils.WaitForTask(ctx, r.client, taskResponse.TaskId); err != nil {
+		resp.Diagnostics.AddError(
+			"Deleting queue",
+			"Task responsible for this operation failed, error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "END__Delete")
+}
+
+func (r *rabbitMqQueueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := utils.SplitResourceId(req.ID, 2, 3)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Importing queue",
+			"Expected import ID in the form <cluster_id>/<vhost>/<name> or <cluster_id>/<name>, error: "+err.Error(),
+		)
+		return
+	}
+	if len(parts) == 2 {
+		parts = []string{parts[0], utils.DefaultVHost, parts[1]}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), utils.JoinResourceId(parts...))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhost"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+	resp.Diagnostics.Append(utils.MarkImported(ctx, resp.Private)...)
+}
+
+func findQueue(metaData *model.ClusterMetaData, vHost string, name string) *model.QueueModel {
+	for i := range metaData.Queues {
+		if metaData.Queues[i].VHost == vHost && metaData.Queues[i].Name == name {
+			return &metaData.Queues[i]
+		}
+	}
+	return nil
+}
+
+// rabbitMqArguments converts the arguments of queue/exchange/binding from plan to request, leaving them nil when not set.
+func rabbitMqArguments(ctx context.Context, arguments types.Map, target *map[string]string) diag.Diagnostics {
+	if arguments.IsNull() || arguments.IsUnknown() {
+		return nil
+	}
+	return arguments.ElementsAs(ctx, target, false)
+}
@@ -519,13 +519,13 @@ func (r *serviceAccountResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get refreshed service account value from TDH
 	svcAcct, err := r.client.CustomerMetadata.GetServiceAccount(state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Updating TDH service account",
-			"Could not update service account, unexpected error: "+err.Error(),
-		)
-		return
-	}
-	if err != nil {
+		apiErr := core.ApiError{}
+		errors.As(err, &apiErr)
+		if apiErr.StatusCode == 404 {
+			tflog.Warn(ctx, "service account not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Reading TDH service account",
 			"Could not read TDH service account ID "+state.ID.ValueString()+": "+err.Error(),
@@ -2,6 +2,7 @@ package tdh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/customer-metadata"
 	"github.com/svc-bot-mds/terraform-provider-tdh/tdh/validators"
 )
@@ -389,48 +391,43 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	query := &customer_metadata.UsersQuery{}
 	if r.client.Root.IsSre {
-		user, err := r.client.CustomerMetadata.GetUsers(query)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Reading TDH user",
-				"Could not read TDH user ID "+state.ID.ValueString()+": "+err.Error(),
-			)
-			return
-		}
-		if user.Page.TotalPages > 1 {
-			for i := 1; i <= user.Page.TotalPages; i++ {
-				query.PageQuery.Index = i - 1
-				page, err := r.client.CustomerMetadata.GetUsers(query)
-				if err != nil {
-					resp.Diagnostics.AddError(
-						"Unable to Read User",
-						err.Error(),
-					)
-					return
-				}
-
-				for _, dto := range *page.Get() {
-					if dto.Id == state.ID.ValueString() {
-						if r.saveFromUserResponse(&ctx, &resp.Diagnostics, &state, &dto) != 0 {
-							return
-						}
-					}
-				}
+		found := false
+		for totalPages := 1; !found && query.PageQuery.Index < totalPages; query.PageQuery.Index++ {
+			page, err := r.client.CustomerMetadata.GetUsers(query)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Reading TDH user",
+					"Could not read TDH user ID "+state.ID.ValueString()+": "+err.Error(),
+				)
+				return
 			}
-
-		} else {
-			for _, dto := range *user.Get() {
+			totalPages = page.Page.TotalPages
+			for _, dto := range *page.Get() {
 				if dto.Id == state.ID.ValueString() {
+					found = true
 					if r.saveFromUserResponse(&ctx, &resp.Diagnostics, &state, &dto) != 0 {
 						return
 					}
+					break
 				}
 			}
 		}
+		if !found {
+			tflog.Warn(ctx, "user not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
 	} else {
 		// Get refreshed cluster value from TDH
 		user, err := r.client.CustomerMetadata.GetUser(state.ID.ValueString())
 		if err != nil {
+			apiErr := core.ApiError{}
+			errors.As(err, &apiErr)
+			if apiErr.StatusCode == 404 {
+				tflog.Warn(ctx, "user not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+				resp.State.RemoveResource(ctx)
+				return
+			}
 			resp.Diagnostics.AddError(
 				"Reading TDH user",
 				"Could not read TDH user ID "+state.ID.ValueString()+": "+err.Error(),
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/core"
+	"slices"
+)
+
+// DefaultVHost is the virtual host present on every RabbitMQ cluster.
+const DefaultVHost = "/"
+
+// GetClusterMetaData returns the metadata of cluster, making sure it's of one of the given service types.
+// Returns nil metadata without error if the cluster doesn't exist anymore.
+func GetClusterMetaData(client *tdh.Client, clusterId string, serviceTypes ...string) (*model.ClusterMetaData, error) {
+	cluster, err := client.Controller.GetCluster(clusterId)
+	if err != nil {
+		apiErr := core.ApiError{}
+		if errors.As(err, &apiErr) && apiErr.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !slices.Contains(serviceTypes, cluster.ServiceType) {
+		return nil, fmt.Errorf("cluster %q is of service type %q, expected one of %v", clusterId, cluster.ServiceType, serviceTypes)
+	}
+	metaData, err := client.Controller.GetClusterMetaData(clusterId)
+	if err != nil {
+		return nil, err
+	}
+	return metaData, nil
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// defaultPorts are the ports services listen on, used when connection URI of cluster doesn't mention one.
+var defaultPorts = map[string]int64{
+	service_type.POSTGRES: 5432,
+	service_type.MYSQL:    3306,
+	service_type.REDIS:    6379,
+	service_type.RABBITMQ: 5672,
+}
+
+// Connection holds the details needed by applications to connect to a cluster.
+type Connection struct {
+	Host     string
+	Port     int64
+	Username string
+	Password string
+	Database string
+	// Uri is the URL understood by clients of the service, like "postgresql://", "mysql://", "redis://" or "amqp://".
+	Uri string
+	// JdbcUrl is only set for Postgres & MySQL.
+	JdbcUrl string
+	// Dsn is the libpq keyword/value connection string, only set for Postgres.
+	Dsn string
+}
+
+// BuildConnection builds the connection details of a cluster from its connection URI & the credentials it was created with.
+func BuildConnection(serviceType string, connectionUri string, username string, password string, database string) (*Connection, error) {
+	host, port, err := parseHostPort(connectionUri, defaultPorts[serviceType])
+	if err != nil {
+		return nil, err
+	}
+	connection := &Connection{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		Database: database,
+	}
+	address := net.JoinHostPort(host, strconv.FormatInt(port, 10))
+	uri := url.URL{Host: address}
+	if username != "" {
+		uri.User = url.UserPassword(username, password)
+		if password == "" {
+			uri.User = url.User(username)
+		}
+	}
+
+	switch serviceType {
+	case service_type.POSTGRES:
+		uri.Scheme, uri.Path = "postgresql", "/"+database
+		connection.JdbcUrl = jdbcUrl("postgresql", address, username, password, database)
+		connection.Dsn = libpqDsn(host, port, username, password, database)
+	case service_type.MYSQL:
+		uri.Scheme, uri.Path = "mysql", "/"+database
+		connection.JdbcUrl = jdbcUrl("mysql", address, username, password, database)
+	case service_type.REDIS:
+		uri.Scheme = "redis"
+	case service_type.RABBITMQ:
+		uri.Scheme, uri.Path = "amqp", "/"
+	default:
+		return connection, nil
+	}
+	connection.Uri = uri.String()
+	return connection, nil
+}
+
+// parseHostPort extracts host & port from either a URL like "postgresql://host:5432/db" or an address like "host:5432".
+func parseHostPort(connectionUri string, defaultPort int64) (string, int64, error) {
+	address := strings.TrimSpace(connectionUri)
+	if address == "" {
+		return "", 0, fmt.Errorf("connection URI is empty")
+	}
+	if strings.Contains(address, "://") {
+		parsed, err := url.Parse(address)
+		if err != nil {
+			return "", 0, err
+		}
+		address = parsed.Host
+	}
+	host, portValue, err := net.SplitHostPort(address)
+	if err != nil {
+		// no port mentioned
+		return strings.Trim(address, "[]"), defaultPort, nil
+	}
+	port, err := strconv.ParseInt(portValue, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port %q in connection URI", portValue)
+	}
+	return host, port, nil
+}
+
+func jdbcUrl(subProtocol string, address string, username string, password string, database string) string {
+	query := url.Values{}
+	if username != "" {
+		query.Set("user", username)
+	}
+	if password != "" {
+		query.Set("password", password)
+	}
+	jdbc := fmt.Sprintf("jdbc:%s://%s/%s", subProtocol, address, url.PathEscape(database))
+	if len(query) > 0 {
+		jdbc += "?" + query.Encode()
+	}
+	return jdbc
+}
+
+func libpqDsn(host string, port int64, username string, password string, database string) string {
+	pairs := []string{"host=" + libpqQuote(host), "port=" + strconv.FormatInt(port, 10)}
+	if username != "" {
+		pairs = append(pairs, "user="+libpqQuote(username))
+	}
+	if password != "" {
+		pairs = append(pairs, "password="+libpqQuote(password))
+	}
+	if database != "" {
+		pairs = append(pairs, "dbname="+libpqQuote(database))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// libpqQuote quotes the value if needed, as described in https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING-KEYWORD-VALUE
+func libpqQuote(value string) string {
+	if value != "" && !strings.ContainsAny(value, " '\\") {
+		return value
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
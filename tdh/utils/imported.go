@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// ImportedKey is the key in the private state of a resource marking it as imported, until its first update.
+// It lets resources adopt the properties API doesn't report from the configuration, instead of replacing the object.
+const ImportedKey = "imported"
+
+// MarkImported marks the resource as imported in the given private state.
+func MarkImported(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, ImportedKey, []byte("true"))
+}
+
+// IsImported returns true if the resource was imported & not updated since.
+func IsImported(ctx context.Context, private PrivateState) bool {
+	value, diags := private.GetKey(ctx, ImportedKey)
+	return !diags.HasError() && string(value) == "true"
+}
+
+// ClearImported removes the import marker from the given private state.
+func ClearImported(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, ImportedKey, []byte("null"))
+}
+
+// ReplaceUnlessImportedDescription describes the Replace*UnlessImported plan modifier funcs, for attributes which can't be
+// changed in-place. Values not reported by API are unknown after import though, so those are adopted from config instead.
+const ReplaceUnlessImportedDescription = "Changing the value forces replacement, unless the resource was just imported."
+
+func ReplaceStringUnlessImported(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !IsImported(ctx, req.Private)
+}
+
+func ReplaceBoolUnlessImported(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !IsImported(ctx, req.Private)
+}
+
+func ReplaceMapUnlessImported(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !IsImported(ctx, req.Private)
+}
+
+func ReplaceSetUnlessImported(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !IsImported(ctx, req.Private)
+}
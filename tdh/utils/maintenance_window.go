@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const maintenanceTimeLayout = "15:04"
+
+// MaintenanceWindow is a weekly recurring window, starting at a time of day (UTC) on a day of week.
+type MaintenanceWindow struct {
+	Day      string
+	Time     string
+	Duration time.Duration
+}
+
+// ParseMaintenanceWindow parses the day (like "SUNDAY"), time (like "02:30") & duration (like "4h") of a window.
+func ParseMaintenanceWindow(day string, timeOfDay string, duration string) (*MaintenanceWindow, error) {
+	if _, err := parseWeekday(day); err != nil {
+		return nil, err
+	}
+	if _, err := time.Parse(maintenanceTimeLayout, timeOfDay); err != nil {
+		return nil, fmt.Errorf("invalid time of day %q, expected format HH:MM", timeOfDay)
+	}
+	parsedDuration, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, err
+	}
+	return &MaintenanceWindow{Day: strings.ToUpper(day), Time: timeOfDay, Duration: parsedDuration}, nil
+}
+
+// MaintenanceWindowFromEpochMillis converts the start & end of a window occurrence, as returned by API.
+func MaintenanceWindowFromEpochMillis(start int64, end int64) *MaintenanceWindow {
+	startTime := time.UnixMilli(start).UTC()
+	return &MaintenanceWindow{
+		Day:      strings.ToUpper(startTime.Weekday().String()),
+		Time:     startTime.Format(maintenanceTimeLayout),
+		Duration: time.UnixMilli(end).Sub(time.UnixMilli(start)),
+	}
+}
+
+// Next returns the start & end of the occurrence of window which hasn't ended yet at the given time.
+func (w *MaintenanceWindow) Next(now time.Time) (time.Time, time.Time) {
+	weekday, _ := parseWeekday(w.Day)
+	timeOfDay, _ := time.Parse(maintenanceTimeLayout, w.Time)
+
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, time.UTC).
+		AddDate(0, 0, int(weekday-now.Weekday()))
+	// the occurrence of this week may have ended already, or the one of last week may still be going on
+	if end := start.AddDate(0, 0, -7).Add(w.Duration); now.Before(end) {
+		start = start.AddDate(0, 0, -7)
+	} else if !now.Before(start.Add(w.Duration)) {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start, start.Add(w.Duration)
+}
+
+// EpochMillis returns the start & end of the next occurrence of window, as expected by API.
+func (w *MaintenanceWindow) EpochMillis(now time.Time) (int64, int64) {
+	start, end := w.Next(now)
+	return start.UnixMilli(), end.UnixMilli()
+}
+
+func parseWeekday(day string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("invalid day of week %q", day)
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func utcTime(year int, month time.Month, day int, hour int, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestMaintenanceWindowNext(t *testing.T) {
+	tests := []struct {
+		name      string
+		day       string
+		time      string
+		duration  string
+		now       time.Time
+		wantStart time.Time
+	}{
+		{
+			name: "later this week",
+			day:  "SATURDAY", time: "02:30", duration: "4h",
+			now:       utcTime(2026, time.October, 19, 10, 0),
+			wantStart: utcTime(2026, time.October, 24, 2, 30),
+		},
+		{
+			name: "later today",
+			day:  "MONDAY", time: "22:00", duration: "1h",
+			now:       utcTime(2026, time.October, 19, 10, 0),
+			wantStart: utcTime(2026, time.October, 19, 22, 0),
+		},
+		{
+			name: "earlier this week, already ended",
+			day:  "MONDAY", time: "02:00", duration: "1h",
+			now:       utcTime(2026, time.October, 21, 10, 0),
+			wantStart: utcTime(2026, time.October, 26, 2, 0),
+		},
+		{
+			name: "ended earlier today",
+			day:  "SUNDAY", time: "02:30", duration: "4h",
+			now:       utcTime(2026, time.October, 18, 7, 0),
+			wantStart: utcTime(2026, time.October, 25, 2, 30),
+		},
+		{
+			name: "started earlier today",
+			day:  "SUNDAY", time: "02:30", duration: "4h",
+			now:       utcTime(2026, time.October, 18, 3, 0),
+			wantStart: utcTime(2026, time.October, 18, 2, 30),
+		},
+		{
+			name: "started yesterday, in the previous week",
+			day:  "SATURDAY", time: "22:00", duration: "4h",
+			now:       utcTime(2026, time.October, 18, 1, 0),
+			wantStart: utcTime(2026, time.October, 17, 22, 0),
+		},
+		{
+			name: "starts now",
+			day:  "MONDAY", time: "10:00", duration: "2h",
+			now:       utcTime(2026, time.October, 19, 10, 0),
+			wantStart: utcTime(2026, time.October, 19, 10, 0),
+		},
+		{
+			name: "ends now",
+			day:  "MONDAY", time: "08:00", duration: "2h",
+			now:       utcTime(2026, time.October, 19, 10, 0),
+			wantStart: utcTime(2026, time.October, 26, 8, 0),
+		},
+		{
+			name: "time in other zone",
+			day:  "MONDAY", time: "02:00", duration: "2h",
+			now:       time.Date(2026, time.October, 18, 22, 30, 0, 0, time.FixedZone("UTC-4", -4*60*60)),
+			wantStart: utcTime(2026, time.October, 19, 2, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window, err := ParseMaintenanceWindow(tt.day, tt.time, tt.duration)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			start, end := window.Next(tt.now)
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantStart.Add(window.Duration)) {
+				t.Errorf("expected window from %s to %s, got from %s to %s",
+					tt.wantStart, tt.wantStart.Add(window.Duration), start, end)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowEpochMillis(t *testing.T) {
+	tests := []struct {
+		name      string
+		window    MaintenanceWindow
+		now       time.Time
+		wantStart int64
+		wantEnd   int64
+	}{
+		{
+			name:      "next week",
+			window:    MaintenanceWindow{Day: "SUNDAY", Time: "02:30", Duration: 4 * time.Hour},
+			now:       utcTime(2026, time.October, 18, 7, 0),
+			wantStart: utcTime(2026, time.October, 25, 2, 30).UnixMilli(),
+			wantEnd:   utcTime(2026, time.October, 25, 6, 30).UnixMilli(),
+		},
+		{
+			name:      "going on",
+			window:    MaintenanceWindow{Day: "SATURDAY", Time: "22:00", Duration: 4 * time.Hour},
+			now:       utcTime(2026, time.October, 18, 1, 0),
+			wantStart: utcTime(2026, time.October, 17, 22, 0).UnixMilli(),
+			wantEnd:   utcTime(2026, time.October, 18, 2, 0).UnixMilli(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.window.EpochMillis(tt.now)
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("expected (%d, %d), got (%d, %d)", tt.wantStart, tt.wantEnd, start, end)
+			}
+			// converting back gives the same window, as when read from API
+			if got := MaintenanceWindowFromEpochMillis(start, end); *got != tt.window {
+				t.Errorf("expected %+v after conversion, got %+v", tt.window, *got)
+			}
+		})
+	}
+}
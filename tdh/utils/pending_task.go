@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"strings"
+)
+
+// PendingTaskKey is the key in the private state of a resource holding its PendingTask.
+const PendingTaskKey = "pending_task"
+
+// PendingTask identifies a creation which was submitted but not yet confirmed, when waiting on it got interrupted.
+// It is saved in the private state so that next run can resume waiting on it & adopt the created object.
+type PendingTask struct {
+	TaskId       string `json:"taskId"`
+	ResourceName string `json:"resourceName"`
+	ServiceType  string `json:"serviceType,omitempty"`
+}
+
+// PrivateState is implemented by the private state data available in the resource requests/responses.
+type PrivateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// SavePendingTask saves the pending task in the given private state.
+func SavePendingTask(ctx context.Context, private PrivateState, pendingTask *PendingTask) diag.Diagnostics {
+	var diags diag.Diagnostics
+	value, err := json.Marshal(pendingTask)
+	if err != nil {
+		diags.AddError("Saving pending task", "Could not serialize pending task: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, PendingTaskKey, value)
+}
+
+// GetPendingTask returns the pending task from the given private state, nil if there's none.
+func GetPendingTask(ctx context.Context, private PrivateState) (*PendingTask, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, PendingTaskKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+	var pendingTask *PendingTask
+	if err := json.Unmarshal(value, &pendingTask); err != nil {
+		diags.AddError("Reading pending task", "Could not deserialize pending task: "+err.Error())
+		return nil, diags
+	}
+	if pendingTask == nil || pendingTask.TaskId == "" {
+		return nil, diags
+	}
+	return pendingTask, diags
+}
+
+// ClearPendingTask removes the pending task from the given private state.
+func ClearPendingTask(ctx context.Context, private PrivateState) diag.Diagnostics {
+	return private.SetKey(ctx, PendingTaskKey, []byte("null"))
+}
+
+// CreatedLookup tells FetchCreated how to look up the objects of a kind, like "cluster".
+type CreatedLookup[T any] struct {
+	Kind string
+	// GetById fetches the object by the ID reported by the task.
+	GetById func(id string) (*T, error)
+	// ListByName lists the objects exactly matching the name (& service type) of the pending task.
+	ListByName func(pendingTask *PendingTask) ([]T, error)
+	// IdOf returns the ID of the object, to list the ambiguous matches.
+	IdOf func(object *T) string
+}
+
+// FetchCreated returns the object created by the given task, nil if it couldn't be found.
+// The object is looked up by the ID reported by the task, falling back to its exact name.
+func FetchCreated[T any](ctx context.Context, diags *diag.Diagnostics, client *tdh.Client, pendingTask *PendingTask, lookup CreatedLookup[T]) *T {
+	title := "Fetching " + lookup.Kind
+	taskDto, err := client.TaskService.GetTask(pendingTask.TaskId)
+	if err != nil {
+		tflog.Warn(ctx, "could not fetch the task creating "+lookup.Kind+", looking it up by name", map[string]interface{}{"task_id": pendingTask.TaskId, "error": err.Error()})
+	} else if taskDto.UiParams.ResourceId != "" {
+		object, err := lookup.GetById(taskDto.UiParams.ResourceId)
+		if err != nil {
+			diags.AddError(title,
+				"Could not fetch the created "+lookup.Kind+" ID "+taskDto.UiParams.ResourceId+", unexpected error: "+err.Error(),
+			)
+			return nil
+		}
+		return object
+	}
+
+	matches, err := lookup.ListByName(pendingTask)
+	if err != nil {
+		diags.AddError(title,
+			"Could not fetch "+lookup.Kind+"s by name, unexpected error: "+err.Error(),
+		)
+		return nil
+	}
+	switch len(matches) {
+	case 0:
+		diags.AddError(title,
+			"Unable to fetch the created "+lookup.Kind,
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+	ids := make([]string, 0, len(matches))
+	for i := range matches {
+		ids = append(ids, lookup.IdOf(&matches[i]))
+	}
+	diags.AddError(title,
+		fmt.Sprintf("Unable to tell the created %s apart, found %d %ss named %q, IDs: %s. "+
+			"Please import the right one.", lookup.Kind, len(matches), lookup.Kind, pendingTask.ResourceName, strings.Join(ids, ", ")),
+	)
+	return nil
+}
+
+// IsInterrupted returns true if the error is caused by the cancellation of the operation, like when terraform is interrupted.
+func IsInterrupted(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// JoinResourceId builds the ID of a resource identified by multiple parts, like cluster ID & name.
+// Parts are path-escaped, so names containing "/" (like the default RabbitMQ vhost) are preserved.
+func JoinResourceId(parts ...string) string {
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = url.PathEscape(part)
+	}
+	return strings.Join(escaped, "/")
+}
+
+// SplitResourceId splits the ID built by JoinResourceId, expecting one of the given number of parts.
+func SplitResourceId(id string, counts ...int) ([]string, error) {
+	escaped := strings.Split(id, "/")
+	valid := false
+	for _, count := range counts {
+		valid = valid || len(escaped) == count
+	}
+	if !valid {
+		return nil, fmt.Errorf("unexpected format of ID %q", id)
+	}
+	parts := make([]string, len(escaped))
+	for i, part := range escaped {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected format of ID %q: %w", id, err)
+		}
+		parts[i] = unescaped
+	}
+	if parts[0] == "" {
+		return nil, fmt.Errorf("unexpected format of ID %q, first part cannot be empty", id)
+	}
+	return parts, nil
+}
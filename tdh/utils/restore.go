@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/controller"
+)
+
+// RestoreTarget is where a backup is restored, either a new cluster or in-place onto the cluster it was taken of.
+type RestoreTarget struct {
+	ClusterName      string
+	StoragePolicy    string
+	NetworkPolicyIds []string
+	Tags             []string
+	InPlace          bool
+}
+
+// NewRestoreRequest builds the request restoring the backup into the target, on the data plane of the backup.
+func NewRestoreRequest(client *tdh.Client, backup *model.ClusterBackup, target RestoreTarget) (*controller.ClusterCreateRequest, error) {
+	request := &controller.ClusterCreateRequest{
+		Name:              target.ClusterName,
+		StoragePolicyName: target.StoragePolicy,
+		NetworkPolicyIds:  target.NetworkPolicyIds,
+		Tags:              target.Tags,
+		Region:            backup.Region,
+		Provider:          backup.Provider,
+		Version:           backup.ClusterVersion,
+		InstanceSize:      backup.Metadata.ClusterSize,
+		ServiceType:       backup.ServiceType,
+		// credentials are restored along with the backup, API still requires them to be present
+		ClusterMetadata: controller.ClusterMetadata{
+			Username: "tdh_internal_user",
+			Password: "********",
+		},
+	}
+	if len(backup.Metadata.Databases) > 0 {
+		request.ClusterMetadata.Database = backup.Metadata.Databases[0]
+	}
+	// cluster being restored keeps its own storage & network policies, API still requires them to be present
+	if target.InPlace {
+		request.Name = backup.ClusterName
+		request.StoragePolicyName = "dummy"
+		request.NetworkPolicyIds = []string{"dummy"}
+	}
+	SetRestoreFrom(request, backup.Id)
+
+	dataPlane, err := client.InfraConnector.GetDataPlaneById(backup.DataPlaneId)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch data plane of the backup: %w", err)
+	}
+	request.Shared = dataPlane.Shared
+	request.Dedicated = len(dataPlane.OrgId) != 0
+	return request, nil
+}
+
+// SetRestoreFrom makes the request restore the given backup.
+func SetRestoreFrom(request *controller.ClusterCreateRequest, backupId string) {
+	request.ClusterMetadata.RestoreFrom = backupId
+	// extensions are restored along with the backup, sending the exact ones fails for some reason
+	request.ClusterMetadata.Extensions = []string{}
+}
+
+// RestoreApi returns the API restoring backups of the given service type, Postgres clusters are restored by the create API.
+func RestoreApi(client *tdh.Client, serviceType string) func(request *controller.ClusterCreateRequest) (*model.TaskResponse, error) {
+	if serviceType == service_type.POSTGRES {
+		return client.Controller.CreateCluster
+	}
+	return client.Controller.RestoreClusterBackup
+}
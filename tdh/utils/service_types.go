@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"sync"
+)
+
+// knownServiceTypes are the service types known at the time of release, used when they can't be discovered from TDH.
+var knownServiceTypes = []model.ServiceType{
+	{
+		Name: service_type.POSTGRES, BackupSupported: true, ExtensionsSupported: true, CredentialsRequired: true, DatabaseRequired: true, CloneSupported: true,
+		Parameters: []string{"max_connections", "work_mem", "maintenance_work_mem", "shared_buffers", "effective_cache_size",
+			"statement_timeout", "idle_in_transaction_session_timeout", "log_min_duration_statement", "random_page_cost"},
+	},
+	{
+		Name: service_type.MYSQL, BackupSupported: true, CredentialsRequired: true, DatabaseRequired: true, CloneSupported: true,
+		Parameters: []string{"max_connections", "innodb_buffer_pool_size", "wait_timeout", "interactive_timeout", "long_query_time",
+			"slow_query_log", "max_allowed_packet", "sql_mode"},
+	},
+	{
+		Name:       service_type.RABBITMQ,
+		Parameters: []string{"plugins", "vm_memory_high_watermark.relative", "disk_free_limit.absolute", "heartbeat"},
+	},
+	{
+		Name: service_type.REDIS, BackupSupported: true, CredentialsRequired: true,
+		Parameters: []string{"maxmemory-policy", "maxmemory-samples", "timeout", "notify-keyspace-events"},
+	},
+}
+
+// discoveredServiceTypes caches the service types discovered per client, they're fetched only once per run.
+var discoveredServiceTypes = struct {
+	sync.Mutex
+	byClient map[*tdh.Client][]model.ServiceType
+}{byClient: map[*tdh.Client][]model.ServiceType{}}
+
+// GetServiceTypes returns the service types enabled on TDH, falling back to the known ones if they can't be discovered.
+// The fallback isn't cached, so that discovery is retried after a transient error.
+func GetServiceTypes(ctx context.Context, client *tdh.Client) []model.ServiceType {
+	if client == nil {
+		return knownServiceTypes
+	}
+	discoveredServiceTypes.Lock()
+	defer discoveredServiceTypes.Unlock()
+	if serviceTypes, ok := discoveredServiceTypes.byClient[client]; ok {
+		return serviceTypes
+	}
+	serviceTypes, err := client.Controller.GetServiceTypes()
+	if err != nil || len(serviceTypes) == 0 {
+		tflog.Warn(ctx, "could not discover service types, using the known ones", map[string]interface{}{"error": err})
+		return knownServiceTypes
+	}
+	// parameters aren't reported by older versions of TDH, the known ones are allowed then
+	for i := range serviceTypes {
+		if serviceTypes[i].Parameters != nil {
+			continue
+		}
+		for _, known := range knownServiceTypes {
+			if known.Name == serviceTypes[i].Name {
+				serviceTypes[i].Parameters = known.Parameters
+			}
+		}
+	}
+	discoveredServiceTypes.byClient[client] = serviceTypes
+	return serviceTypes
+}
+
+// KnownServiceTypeNames returns the names of the service types known at the time of release.
+func KnownServiceTypeNames() []string {
+	names := make([]string, 0, len(knownServiceTypes))
+	for _, serviceType := range knownServiceTypes {
+		names = append(names, serviceType.Name)
+	}
+	return names
+}
+
+// GetServiceType returns the given service type if it's enabled on TDH, nil otherwise.
+func GetServiceType(ctx context.Context, client *tdh.Client, name string) *model.ServiceType {
+	serviceTypes := GetServiceTypes(ctx, client)
+	for i := range serviceTypes {
+		if serviceTypes[i].Name == name {
+			return &serviceTypes[i]
+		}
+	}
+	return nil
+}
+
+// GetServiceTypeNames returns the names of the service types enabled on TDH.
+func GetServiceTypeNames(ctx context.Context, client *tdh.Client) []string {
+	serviceTypes := GetServiceTypes(ctx, client)
+	names := make([]string, 0, len(serviceTypes))
+	for _, serviceType := range serviceTypes {
+		names = append(names, serviceType.Name)
+	}
+	return names
+}
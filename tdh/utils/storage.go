@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	storageSizePattern = regexp.MustCompile(`^\s*([0-9]*\.?[0-9]+)\s*([a-zA-Z]*)\s*$`)
+	storageUnits       = map[string]float64{
+		"":   1,
+		"b":  1,
+		"k":  1e3,
+		"kb": 1e3,
+		"ki": 1 << 10,
+		"m":  1e6,
+		"mb": 1e6,
+		"mi": 1 << 20,
+		"g":  1e9,
+		"gb": 1e9,
+		"gi": 1 << 30,
+		"t":  1e12,
+		"tb": 1e12,
+		"ti": 1 << 40,
+	}
+)
+
+// ParseStorageSize converts sizes like "10Gi", "512 MB" or "1.5T" to number of bytes.
+func ParseStorageSize(size string) (float64, error) {
+	match := storageSizePattern.FindStringSubmatch(size)
+	if match == nil {
+		return 0, fmt.Errorf("invalid storage size %q", size)
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid storage size %q: %w", size, err)
+	}
+	multiplier, ok := storageUnits[strings.ToLower(match[2])]
+	if !ok {
+		return 0, fmt.Errorf("invalid storage unit in %q", size)
+	}
+	return value * multiplier, nil
+}
@@ -2,131 +2,349 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/task_status"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/task"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 )
 
+const (
+	taskPollMinInterval = 5 * time.Second
+	taskPollMaxInterval = 60 * time.Second
+	taskPollBatchSize   = 50
+	// number of consecutive errors fetching a task after which its waiters are given up on
+	taskPollMaxErrors = 3
+)
+
+var (
+	pollers     = map[*tdh.Client]*TaskPoller{}
+	pollersLock sync.Mutex
+)
+
 type taskWaitResponse struct {
 	TaskId string `json:"taskId"`
 	Error  error  `json:"error"`
 }
 
-func WaitForTask(client *tdh.Client, taskId string) error {
-	for true {
-		taskResponse, err := client.TaskService.GetTask(taskId)
-		if err != nil {
-			return err
+// TaskFailedError is returned by the waiters when a task ends up with status FAILED.
+type TaskFailedError struct {
+	Task *model.Task
+}
+
+func (e *TaskFailedError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("task [ID: %s] has failed", e.Task.Id))
+	if reason := e.Task.FailureReason(); reason != "" {
+		sb.WriteString(fmt.Sprintf(": %s", reason))
+	}
+	if step := e.Task.LastStep(); step != nil {
+		sb.WriteString(fmt.Sprintf(" (last step reached: %q)", step.Name))
+	}
+	if e.Task.TimeStarted != "" || e.Task.TimeCompleted != "" {
+		sb.WriteString(fmt.Sprintf(", started: %q, ended: %q", e.Task.TimeStarted, e.Task.TimeCompleted))
+	}
+	sb.WriteString(", get more details using datasource \"tdh_tasks\"")
+	return sb.String()
+}
+
+// taskProgressLogger logs the task details whenever its status or current step changes.
+type taskProgressLogger struct {
+	ctx        context.Context
+	lastStatus string
+	lastStep   string
+}
+
+func (l *taskProgressLogger) log(task *model.Task) {
+	var stepName string
+	if step := task.LastStep(); step != nil {
+		stepName = step.Name
+	}
+	if task.Status == l.lastStatus && stepName == l.lastStep {
+		return
+	}
+	l.lastStatus, l.lastStep = task.Status, stepName
+	tflog.Info(l.ctx, "task progress", map[string]interface{}{
+		"task_id":   task.Id,
+		"task_type": task.TaskType,
+		"status":    task.Status,
+		"step":      stepName,
+		"resource":  task.UiParams.ResourceName,
+	})
+}
+
+// taskSubscription is registered by a waiter for a single task.
+type taskSubscription struct {
+	logger  *taskProgressLogger
+	results chan<- taskWaitResponse
+}
+
+// taskFetcher is the part of the task service used for polling.
+type taskFetcher interface {
+	GetTasks(query *task.TasksQuery) (model.Paged[model.Task], error)
+	GetTask(id string) (*model.Task, error)
+}
+
+// TaskPoller tracks all the pending tasks of a client and polls them in batches,
+// so that concurrent operations across resources don't hit the API for each task separately.
+type TaskPoller struct {
+	tasks       taskFetcher
+	lock        sync.Mutex
+	pending     map[string][]*taskSubscription
+	lastStatus  map[string]string
+	errors      map[string]int
+	running     bool
+	wakeUp      chan struct{}
+	minInterval time.Duration
+	maxInterval time.Duration
+}
+
+// GetTaskPoller returns the poller shared by everyone using the given client.
+func GetTaskPoller(client *tdh.Client) *TaskPoller {
+	pollersLock.Lock()
+	defer pollersLock.Unlock()
+	if poller, ok := pollers[client]; ok {
+		return poller
+	}
+	poller := newTaskPoller(client.TaskService)
+	pollers[client] = poller
+	return poller
+}
+
+func newTaskPoller(tasks taskFetcher) *TaskPoller {
+	return &TaskPoller{
+		tasks:       tasks,
+		pending:     map[string][]*taskSubscription{},
+		lastStatus:  map[string]string{},
+		errors:      map[string]int{},
+		wakeUp:      make(chan struct{}, 1),
+		minInterval: taskPollMinInterval,
+		maxInterval: taskPollMaxInterval,
+	}
+}
+
+// WaitForTask blocks until all the given tasks reach a terminal status, or the context is cancelled.
+// Every failed task is reported in the returned error.
+func WaitForTask(ctx context.Context, client *tdh.Client, taskIds ...string) error {
+	return GetTaskPoller(client).Wait(ctx, taskIds...)
+}
+
+// TaskIdsOf extracts the task IDs from the responses of submitted requests.
+func TaskIdsOf(responses []model.TaskResponse) []string {
+	taskIds := make([]string, 0, len(responses))
+	for _, response := range responses {
+		taskIds = append(taskIds, response.TaskId)
+	}
+	return taskIds
+}
+
+// Wait blocks until all the given tasks reach a terminal status, or the context is cancelled.
+func (p *TaskPoller) Wait(ctx context.Context, taskIds ...string) error {
+	uniqueIds := make([]string, 0, len(taskIds))
+	seen := make(map[string]bool, len(taskIds))
+	for _, taskId := range taskIds {
+		if strings.TrimSpace(taskId) == "" {
+			return fmt.Errorf("task ID cannot be empty")
 		}
-		if taskResponse.Status == "SUCCESS" {
-			return nil
-		} else if taskResponse.Status == "FAILED" {
-			return fmt.Errorf("task [ID: %s] has failed, get more details using datasource \"tdh_tasks\"", taskId)
+		if !seen[taskId] {
+			seen[taskId] = true
+			uniqueIds = append(uniqueIds, taskId)
 		}
-		time.Sleep(time.Second * 10)
 	}
-	return nil
-}
+	if len(uniqueIds) == 0 {
+		return nil
+	}
 
-func WaitForTaskV2(client *tdh.Client, taskId string, superChan *chan taskWaitResponse, wg *sync.WaitGroup) chan error {
-	ch := make(chan error, 1)
-	sendIt := func(taskId string, err error) {
-		if superChan == nil {
-			ch <- err
-		} else {
-			*superChan <- taskWaitResponse{taskId, err}
+	results := make(chan taskWaitResponse, len(uniqueIds))
+	subscriptions := make(map[string]*taskSubscription, len(uniqueIds))
+	for _, taskId := range uniqueIds {
+		subscriptions[taskId] = &taskSubscription{
+			logger:  &taskProgressLogger{ctx: ctx},
+			results: results,
 		}
 	}
-	go func(sendIt func(taskId string, err error)) {
-		for true {
-			taskResponse, err := client.TaskService.GetTask(taskId)
-			if err != nil {
-				sendIt(taskId, err)
-				break
-			}
-			if taskResponse.Status == "SUCCESS" {
-				sendIt(taskId, nil)
-				break
-			} else if taskResponse.Status == "FAILED" {
-				sendIt(taskId, fmt.Errorf("task [ID: %s] has failed, get more details using datasource \"tdh_tasks\"", taskId))
-				break
+	p.subscribe(subscriptions)
+	defer p.unsubscribe(subscriptions)
+
+	var failures []error
+	for remaining := len(uniqueIds); remaining > 0; remaining-- {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for task(s) %q: %w", uniqueIds, ctx.Err())
+		case response := <-results:
+			delete(subscriptions, response.TaskId)
+			if response.Error != nil {
+				failures = append(failures, response.Error)
 			}
-			time.Sleep(time.Second * 10)
-		}
-		if wg != nil {
-			wg.Done()
 		}
-	}(sendIt)
-	return ch
+	}
+	return errors.Join(failures...)
 }
 
-func WaitForAllTasks(client *tdh.Client, taskResponseList []model.TaskResponse) error {
-	if len(taskResponseList) == 0 {
-		return nil
+func (p *TaskPoller) subscribe(subscriptions map[string]*taskSubscription) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for taskId, subscription := range subscriptions {
+		p.pending[taskId] = append(p.pending[taskId], subscription)
 	}
-	var taskIds []string
-	for _, taskResponse := range taskResponseList {
-		taskIds = append(taskIds, taskResponse.TaskId)
+	if !p.running {
+		p.running = true
+		go p.run()
 	}
-	totalTasks := len(taskIds)
-	bokaChan := make(chan taskWaitResponse, totalTasks)
-	wg := sync.WaitGroup{}
-	for _, taskId := range taskIds {
-		wg.Add(1)
-		go WaitForTaskV2(client, taskId, &bokaChan, &wg)
+	select {
+	case p.wakeUp <- struct{}{}:
+	default:
 	}
+}
 
-	// now we wait for everyone to finish - again, not a must.
-	// you can just receive from the channel N times, and use a timeout or something for safety
-	wg.Wait()
+func (p *TaskPoller) unsubscribe(subscriptions map[string]*taskSubscription) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for taskId, subscription := range subscriptions {
+		remaining := p.pending[taskId][:0]
+		for _, existing := range p.pending[taskId] {
+			if existing != subscription {
+				remaining = append(remaining, existing)
+			}
+		}
+		if len(remaining) == 0 {
+			delete(p.pending, taskId)
+			delete(p.lastStatus, taskId)
+			delete(p.errors, taskId)
+		} else {
+			p.pending[taskId] = remaining
+		}
+	}
+}
 
-	// we need to close the channel or the following loop will get stuck
-	close(bokaChan)
+// run polls the pending tasks until there are none left. The interval between rounds is reset
+// whenever some task changes status or a new one is registered, and grows otherwise.
+func (p *TaskPoller) run() {
+	interval := p.minInterval
+	for {
+		taskIds := p.pendingIds()
+		if taskIds == nil {
+			return
+		}
+		changed, failed := p.poll(taskIds)
+		switch {
+		case failed:
+			interval = min(interval*2, p.maxInterval)
+		case changed:
+			interval = p.minInterval
+		default:
+			interval = min(interval*3/2, p.maxInterval)
+		}
 
-	var failedTaskIds []string
-	for response := range bokaChan {
-		if response.Error != nil {
-			taskIds = append(taskIds, response.TaskId)
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-p.wakeUp:
+			timer.Stop()
+			interval = p.minInterval
 		}
 	}
-	if len(failedTaskIds) > 0 {
-		return fmt.Errorf("some tasks have failed %q, get more details using datasource \"tdh_tasks\"", failedTaskIds)
+}
+
+// pendingIds returns nil, and marks the poller as stopped, when nothing is pending.
+func (p *TaskPoller) pendingIds() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.pending) == 0 {
+		p.running = false
+		return nil
+	}
+	taskIds := make([]string, 0, len(p.pending))
+	for taskId := range p.pending {
+		taskIds = append(taskIds, taskId)
 	}
-	return nil
+	return taskIds
 }
 
-func WaitForTaskV3(client *tdh.Client, taskId string) error {
-	var wg sync.WaitGroup
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	wg.Add(1)
-	pollingChan := make(chan error)
-	go func(channel chan error) {
-		defer wg.Done()
-		ticker := time.NewTicker(10 * time.Second)
-		for {
-			taskResponse, err := client.TaskService.GetTask(taskId)
-			if err != nil {
-				channel <- err
-				return
+// poll fetches the given tasks in batches and notifies the subscribers. Returns true if any task has changed its status,
+// and if any task couldn't be fetched. A task which can't be fetched fails only its own waiters, once it keeps failing.
+func (p *TaskPoller) poll(taskIds []string) (changed bool, failed bool) {
+	for start := 0; start < len(taskIds); start += taskPollBatchSize {
+		batch := taskIds[start:min(start+taskPollBatchSize, len(taskIds))]
+		query := &task.TasksQuery{Ids: batch}
+		query.Size = len(batch)
+		fetched := make(map[string]bool, len(batch))
+		// when the batch can't be fetched, each of its tasks is fetched individually to tell the bad ones apart
+		if response, err := p.tasks.GetTasks(query); err == nil {
+			tasks := *response.Get()
+			for i := range tasks {
+				if !fetched[tasks[i].Id] && slices.Contains(batch, tasks[i].Id) {
+					fetched[tasks[i].Id] = true
+					changed = p.dispatch(&tasks[i]) || changed
+				}
 			}
-			if taskResponse.Status == "SUCCESS" {
-				channel <- nil
-				return
-			} else if taskResponse.Status == "FAILED" {
-				channel <- fmt.Errorf("task has failed, get more details using datasource \"tdh_tasks\"")
-				return
+		}
+		for _, taskId := range batch {
+			if fetched[taskId] {
+				continue
 			}
-			select {
-			case <-ctx.Done():
-				fmt.Println("cancelled")
-				return
-			case <-ticker.C:
+			taskDto, err := p.tasks.GetTask(taskId)
+			if err != nil {
+				p.recordError(taskId, err)
+				failed = true
+				continue
 			}
+			changed = p.dispatch(taskDto) || changed
 		}
-	}(pollingChan)
-	wg.Wait()
-	return <-pollingChan
+	}
+	return changed, failed
+}
+
+func (p *TaskPoller) dispatch(taskDto *model.Task) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	subscriptions, ok := p.pending[taskDto.Id]
+	if !ok {
+		return false
+	}
+	delete(p.errors, taskDto.Id)
+	changed := p.lastStatus[taskDto.Id] != taskDto.Status
+	p.lastStatus[taskDto.Id] = taskDto.Status
+	for _, subscription := range subscriptions {
+		subscription.logger.log(taskDto)
+	}
+	if !task_status.IsTerminal(taskDto.Status) {
+		return changed
+	}
+	var err error
+	if taskDto.Status == task_status.FAILED {
+		err = &TaskFailedError{Task: taskDto}
+	}
+	p.notify(taskDto.Id, subscriptions, err)
+	return changed
+}
+
+// recordError counts a consecutive error fetching the task, failing its waiters once there are too many.
+func (p *TaskPoller) recordError(taskId string, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	subscriptions, ok := p.pending[taskId]
+	if !ok {
+		return
+	}
+	p.errors[taskId]++
+	if p.errors[taskId] >= taskPollMaxErrors {
+		p.notify(taskId, subscriptions, fmt.Errorf("could not poll task [ID: %s]: %w", taskId, err))
+	}
+}
+
+// notify must be called while holding the lock.
+func (p *TaskPoller) notify(taskId string, subscriptions []*taskSubscription, err error) {
+	delete(p.pending, taskId)
+	delete(p.lastStatus, taskId)
+	delete(p.errors, taskId)
+	for _, subscription := range subscriptions {
+		subscription.results <- taskWaitResponse{TaskId: taskId, Error: err}
+	}
 }
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/task_status"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/model"
+	"github.com/svc-bot-mds/terraform-provider-tdh/client/tdh/task"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTasks serves tasks from memory, recording the calls made to it.
+type fakeTasks struct {
+	lock          sync.Mutex
+	statuses      map[string]string
+	notInPage     map[string]bool
+	notFound      map[string]bool
+	batchErr      error
+	batches       [][]string
+	singleFetches []string
+}
+
+func newFakeTasks(statuses map[string]string) *fakeTasks {
+	return &fakeTasks{statuses: statuses, notInPage: map[string]bool{}, notFound: map[string]bool{}}
+}
+
+func (f *fakeTasks) setStatus(taskId string, status string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.statuses[taskId] = status
+}
+
+func (f *fakeTasks) GetTasks(query *task.TasksQuery) (model.Paged[model.Task], error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.batches = append(f.batches, append([]string(nil), query.Ids...))
+	if f.batchErr != nil {
+		return model.Paged[model.Task]{}, f.batchErr
+	}
+	var tasks []model.Task
+	for _, taskId := range query.Ids {
+		if f.notInPage[taskId] || f.notFound[taskId] {
+			continue
+		}
+		tasks = append(tasks, model.Task{Id: taskId, Status: f.statuses[taskId]})
+	}
+	return model.Paged[model.Task]{Embedded: map[string][]model.Task{"taskDTOList": tasks}}, nil
+}
+
+func (f *fakeTasks) GetTask(id string) (*model.Task, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.singleFetches = append(f.singleFetches, id)
+	if f.notFound[id] {
+		return nil, fmt.Errorf("task %s not found", id)
+	}
+	return &model.Task{Id: id, Status: f.statuses[id]}, nil
+}
+
+func newTestPoller(tasks taskFetcher) *TaskPoller {
+	poller := newTaskPoller(tasks)
+	poller.minInterval = time.Millisecond
+	poller.maxInterval = 5 * time.Millisecond
+	return poller
+}
+
+func waitWithTimeout(t *testing.T, poller *TaskPoller, taskIds ...string) error {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := poller.Wait(ctx, taskIds...)
+	if errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("timed out waiting for tasks %q", taskIds)
+	}
+	return err
+}
+
+func TestTaskPollerBatching(t *testing.T) {
+	statuses := map[string]string{}
+	var taskIds []string
+	for i := 0; i < 2*taskPollBatchSize+10; i++ {
+		taskId := fmt.Sprintf("task-%d", i)
+		statuses[taskId] = task_status.SUCCESS
+		taskIds = append(taskIds, taskId)
+	}
+	tasks := newFakeTasks(statuses)
+
+	if err := waitWithTimeout(t, newTestPoller(tasks), taskIds...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fetched := 0
+	for _, batch := range tasks.batches {
+		if len(batch) > taskPollBatchSize {
+			t.Errorf("batch of %d tasks exceeds the limit of %d", len(batch), taskPollBatchSize)
+		}
+		fetched += len(batch)
+	}
+	if fetched != len(taskIds) || len(tasks.batches) != 3 {
+		t.Errorf("expected %d tasks fetched in 3 batches, got %d in %d", len(taskIds), fetched, len(tasks.batches))
+	}
+	if len(tasks.singleFetches) != 0 {
+		t.Errorf("expected no individual fetches, got %q", tasks.singleFetches)
+	}
+}
+
+func TestTaskPollerFetchesMissingIdIndividually(t *testing.T) {
+	tasks := newFakeTasks(map[string]string{"listed": task_status.SUCCESS, "unlisted": task_status.SUCCESS})
+	tasks.notInPage["unlisted"] = true
+
+	if err := waitWithTimeout(t, newTestPoller(tasks), "listed", "unlisted"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tasks.singleFetches) != 1 || tasks.singleFetches[0] != "unlisted" {
+		t.Errorf("expected only the unlisted task fetched individually, got %q", tasks.singleFetches)
+	}
+}
+
+func TestTaskPollerReportsFailedTask(t *testing.T) {
+	tasks := newFakeTasks(map[string]string{"ok": task_status.SUCCESS, "ko": task_status.FAILED})
+
+	err := waitWithTimeout(t, newTestPoller(tasks), "ok", "ko")
+	var failedErr *TaskFailedError
+	if !errors.As(err, &failedErr) || failedErr.Task.Id != "ko" {
+		t.Fatalf("expected failure of task ko, got: %v", err)
+	}
+}
+
+func TestTaskPollerCancellation(t *testing.T) {
+	tasks := newFakeTasks(map[string]string{"running": "IN_PROGRESS"})
+	poller := newTestPoller(tasks)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := poller.Wait(ctx, "running")
+	if !IsInterrupted(err) {
+		t.Fatalf("expected interruption, got: %v", err)
+	}
+	poller.lock.Lock()
+	defer poller.lock.Unlock()
+	if len(poller.pending) != 0 {
+		t.Errorf("expected no pending tasks after cancellation, got %d", len(poller.pending))
+	}
+}
+
+func TestTaskPollerFailsOnlyTheTaskThatCantBeFetched(t *testing.T) {
+	for name, batchErr := range map[string]error{
+		"batch fetched":      nil,
+		"batch fetch failed": fmt.Errorf("bad request"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			tasks := newFakeTasks(map[string]string{"good": "IN_PROGRESS"})
+			tasks.notFound["bad"] = true
+			tasks.batchErr = batchErr
+			poller := newTestPoller(tasks)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			goodResult := make(chan error, 1)
+			go func() {
+				goodResult <- poller.Wait(ctx, "good")
+			}()
+			err := waitWithTimeout(t, poller, "bad")
+			if err == nil || !strings.Contains(err.Error(), "could not poll task [ID: bad]") {
+				t.Fatalf("expected polling error for task bad, got: %v", err)
+			}
+
+			select {
+			case err := <-goodResult:
+				t.Fatalf("task good should still be waited on, got: %v", err)
+			default:
+			}
+			tasks.setStatus("good", task_status.SUCCESS)
+			if err := <-goodResult; err != nil {
+				t.Fatalf("unexpected error for task good: %v", err)
+			}
+		})
+	}
+}
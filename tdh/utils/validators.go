@@ -3,10 +3,11 @@ package utils
 import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/identity_type"
-	"github.com/svc-bot-mds/terraform-provider-tdh/client/constants/service_type"
+	"regexp"
 )
 
 var (
-	ServiceTypeValidator  = stringvalidator.OneOf(service_type.GetAll()...)
+	// ServiceTypeValidator only checks the format, service types enabled on TDH are discovered from it & can't be known while validating.
+	ServiceTypeValidator  = stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`), "must be a service type in upper case, see datasource `tdh_service_types`")
 	IdentityTypeValidator = stringvalidator.OneOf(identity_type.GetAll()...)
 )
@@ -0,0 +1,38 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = &DurationValidator{}
+
+type DurationValidator struct {
+	expressions path.Expressions
+}
+
+func (s DurationValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Must be a valid positive duration like \"30s\", \"10m\" or \"1h30m\"")
+}
+
+func (s DurationValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Must be a valid positive duration like `30s`, `10m` or `1h30m`")
+}
+
+func (s DurationValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Duration", err.Error())
+		return
+	}
+	if duration <= 0 {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Duration", "Duration must be positive")
+	}
+}
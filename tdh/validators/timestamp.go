@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = &RFC3339Validator{}
+
+type RFC3339Validator struct {
+	expressions path.Expressions
+}
+
+func (s RFC3339Validator) Description(_ context.Context) string {
+	return fmt.Sprintf("Must be a valid RFC3339 timestamp like \"2024-01-02T15:04:05Z\"")
+}
+
+func (s RFC3339Validator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Must be a valid RFC3339 timestamp like `2024-01-02T15:04:05Z`")
+}
+
+func (s RFC3339Validator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(request.Path, "Invalid Timestamp", err.Error())
+	}
+}